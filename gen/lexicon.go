@@ -0,0 +1,103 @@
+// The following directive is necessary to build the package:
+
+//go:build ignore
+// +build ignore
+
+// This program generates gen_lexicon.go. It can be invoked by running
+//go:generate
+
+//go:generate go run ../gen/lexicon.go
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+)
+
+var packageTemplate = template.Must(template.New("").Parse(`// Code generated by go generate; DO NOT EDIT.
+// This file was generated by robots at
+// {{ .Timestamp }}
+// using data from
+// {{ .JSON }}
+package tokenizer
+
+// maxCompoundPartLength is the length in bytes of the longest word known to
+// IsGermanCompoundPart
+const maxCompoundPartLength = {{ .MaxLength }}
+
+// IsGermanCompoundPart returns true if word is a German word that compounds
+// are made of. Words with umlauts and ß are known in their transliterated
+// form, too.
+func IsGermanCompoundPart(word string) bool {
+	switch word {
+	case {{ range $idx, $item := .WORDS }}{{ if $idx }},
+		{{ end }}"{{ $item }}"{{ end }}:
+		return true
+	default:
+		return false
+	}
+}
+`))
+
+func main() {
+
+	jsonPath := path.Join(
+		"..", "gen", "lexicon_de.json",
+	)
+
+	genPath := path.Join(
+		"..", "tokenizer", "gen_lexicon.go",
+	)
+
+	words := []string{}
+	if file, err := ioutil.ReadFile(jsonPath); err == nil {
+		if err = json.Unmarshal([]byte(file), &words); err != nil {
+			log.Fatal(err)
+		}
+	} else {
+		log.Fatal(err)
+	}
+
+	// add the transliterated form, e.g. fussball for fußball
+	replacer := strings.NewReplacer("ä", "ae", "ö", "oe", "ü", "ue", "ß", "ss")
+	known := map[string]bool{}
+	for _, word := range words {
+		known[word] = true
+		known[replacer.Replace(word)] = true
+	}
+	words = words[:0]
+	maxLength := 0
+	for word := range known {
+		words = append(words, word)
+		if len(word) > maxLength {
+			maxLength = len(word)
+		}
+	}
+	sort.Strings(words)
+
+	if f, err := os.Create(genPath); err != nil {
+		log.Fatal(err)
+	} else {
+		defer f.Close()
+		packageTemplate.Execute(f, struct {
+			Timestamp time.Time
+			JSON      []string
+			WORDS     []string
+			MaxLength int
+		}{
+			Timestamp: time.Now(),
+			JSON:      []string{jsonPath},
+			WORDS:     words,
+			MaxLength: maxLength,
+		})
+	}
+
+}
@@ -0,0 +1,142 @@
+// The following directive is necessary to build the package:
+
+//go:build ignore
+// +build ignore
+
+// This program generates gen_publicsuffix.go. It can be invoked by running
+//go:generate
+
+//go:generate go run ../gen/publicsuffix.go
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// rule flags, must match the constants in tokenizer/publicsuffix.go
+const (
+	ruleNormal    = 1
+	ruleWildcard  = 2
+	ruleException = 4
+	ruleParent    = 8
+)
+
+var flagNames = []string{"ruleNormal", "ruleWildcard", "ruleException", "ruleParent"}
+
+var packageTemplate = template.Must(template.New("").Funcs(template.FuncMap{
+	"flags": func(flags uint8) string {
+		names := []string{}
+		for i, name := range flagNames {
+			if flags&(1<<i) != 0 {
+				names = append(names, name)
+			}
+		}
+		return strings.Join(names, " | ")
+	},
+}).Parse(`// Code generated by go generate; DO NOT EDIT.
+// This file was generated by robots at
+// {{ .Timestamp }}
+// using data from
+// {{ .JSON }}
+// The public suffix list is published by https://publicsuffix.org under the
+// Mozilla Public License 2.0.
+package tokenizer
+
+// publicSuffixRule returns the rules of the public suffix list for domain
+func publicSuffixRule(domain string) uint8 {
+	switch domain {
+	{{- range .RULES }}
+	case {{ range $idx, $item := .Domains }}{{ if $idx }},
+		{{ end }}"{{ $item }}"{{ end }}:
+		return {{ flags .Flags }}
+	{{- end }}
+	default:
+		return 0
+	}
+}
+`))
+
+type ruleGroup struct {
+	Flags   uint8
+	Domains []string
+}
+
+func main() {
+
+	jsonPath := path.Join(
+		"..", "gen", "public_suffix_list.json",
+	)
+
+	genPath := path.Join(
+		"..", "tokenizer", "gen_publicsuffix.go",
+	)
+
+	rules := []string{}
+	if file, err := ioutil.ReadFile(jsonPath); err == nil {
+		if err = json.Unmarshal([]byte(file), &rules); err != nil {
+			log.Fatal(err)
+		}
+	} else {
+		log.Fatal(err)
+	}
+
+	flags := map[string]uint8{}
+	for _, rule := range rules {
+		domain := rule
+		switch {
+		case strings.HasPrefix(rule, "!"):
+			domain = rule[1:]
+			flags[domain] |= ruleException
+		case strings.HasPrefix(rule, "*."):
+			domain = rule[2:]
+			flags[domain] |= ruleWildcard
+		default:
+			flags[domain] |= ruleNormal
+		}
+		// the suffixes of the domain are parents, so that lookups from the
+		// shortest suffix of a host can stop at the first that is none
+		for idx := strings.IndexByte(domain, '.'); idx >= 0; idx = strings.IndexByte(domain, '.') {
+			domain = domain[idx+1:]
+			flags[domain] |= ruleParent
+		}
+	}
+
+	groups := map[uint8]*ruleGroup{}
+	for domain, flag := range flags {
+		if groups[flag] == nil {
+			groups[flag] = &ruleGroup{Flags: flag}
+		}
+		groups[flag].Domains = append(groups[flag].Domains, domain)
+	}
+	sorted := []*ruleGroup{}
+	for _, group := range groups {
+		sort.Strings(group.Domains)
+		sorted = append(sorted, group)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Flags < sorted[j].Flags })
+
+	if f, err := os.Create(genPath); err != nil {
+		log.Fatal(err)
+	} else {
+		defer f.Close()
+		packageTemplate.Execute(f, struct {
+			Timestamp time.Time
+			JSON      []string
+			RULES     []*ruleGroup
+		}{
+			Timestamp: time.Now(),
+			JSON:      []string{jsonPath},
+			RULES:     sorted,
+		})
+	}
+
+}
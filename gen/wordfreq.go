@@ -0,0 +1,72 @@
+// The following directive is necessary to build the package:
+
+//go:build ignore
+// +build ignore
+
+// This program generates wordfreq_en.json from a plain text corpus, the words
+// of the corpus ordered by their number of occurrences. It is invoked in the
+// tokenizer directory with the path of the corpus:
+//
+//	go run ../gen/wordfreq.go big.txt
+//
+// The corpus is big.txt of https://norvig.com/spell-correct.html, as shipped
+// in data/big.txt of github.com/sajari/fuzzy@v1.0.0 (MIT License), sha256
+// fa066c7d40f0f201ac4144e652aa62430e58a6b3805ec70650f678da5804e87b. It
+// consists of public domain books of Project Gutenberg and lists of the most
+// frequent words of Wiktionary and the British National Corpus.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"sort"
+)
+
+// maxWords is the number of most frequent words that are kept
+const maxWords = 10000
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatal("usage: go run ../gen/wordfreq.go CORPUS")
+	}
+	corpus, err := ioutil.ReadFile(os.Args[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// words are runs of ASCII letters, like in the spelling corrector
+	counts := map[string]int{}
+	for _, word := range bytes.FieldsFunc(bytes.ToLower(corpus), func(r rune) bool {
+		return r < 'a' || r > 'z'
+	}) {
+		counts[string(word)]++
+	}
+
+	words := make([]string, 0, len(counts))
+	for word := range counts {
+		words = append(words, word)
+	}
+	// ties are ordered alphabetically, so the list is reproducible
+	sort.Slice(words, func(i, j int) bool {
+		if counts[words[i]] != counts[words[j]] {
+			return counts[words[i]] > counts[words[j]]
+		}
+		return words[i] < words[j]
+	})
+	if len(words) > maxWords {
+		words = words[:maxWords]
+	}
+
+	data, err := json.MarshalIndent(words, "", "   ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = ioutil.WriteFile(path.Join("..", "gen", "wordfreq_en.json"), append(data, '\n'), 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
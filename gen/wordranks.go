@@ -0,0 +1,112 @@
+// The following directive is necessary to build the package:
+
+//go:build ignore
+// +build ignore
+
+// This program generates gen_wordranks.go. It can be invoked by running
+//go:generate
+
+//go:generate go run ../gen/wordranks.go
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"text/template"
+	"time"
+)
+
+var packageTemplate = template.Must(template.New("").Parse(`// Code generated by go generate; DO NOT EDIT.
+// This file was generated by robots at
+// {{ .Timestamp }}
+// using data from
+// {{ .JSON }}
+// The words are ordered by their number of occurrences in big.txt of
+// https://norvig.com/spell-correct.html, public domain books of Project
+// Gutenberg and lists of frequent words of Wiktionary and the British National
+// Corpus, as shipped with github.com/sajari/fuzzy under the MIT License.
+package tokenizer
+
+// maxRankedWordLength is the length in bytes of the longest word known to
+// the word rank functions
+const maxRankedWordLength = {{ .MaxLength }}
+{{- range $funcName, $words := .RANKS }}
+
+// {{ $funcName }} returns the frequency rank of word, starting at 1 for the
+// most frequent word, or 0 if word is unknown
+func {{ $funcName }}(word string) int {
+	switch word {
+	{{- range $idx, $item := $words }}
+	case "{{ $item.Word }}":
+		return {{ $item.Rank }}
+	{{- end }}
+	default:
+		return 0
+	}
+}
+{{- end }}
+`))
+
+type wordRank struct {
+	Word string
+	Rank int
+}
+
+func main() {
+
+	// wordfreq_en.json is generated by gen/wordfreq.go, never edit it by hand
+	jsonPaths := []string{path.Join(
+		"..", "gen", "wordfreq_en.json",
+	)}
+
+	files := map[string]string{
+		"EnglishWordRank": jsonPaths[0],
+	}
+
+	genPath := path.Join(
+		"..", "tokenizer", "gen_wordranks.go",
+	)
+
+	ranks := map[string][]wordRank{}
+	maxLength := 0
+	for name, file := range files {
+		words := []string{}
+		if data, err := ioutil.ReadFile(file); err == nil {
+			if err = json.Unmarshal([]byte(data), &words); err != nil {
+				log.Fatal(err)
+			}
+		} else {
+			log.Fatal(err)
+		}
+
+		// the words are ordered by frequency
+		for idx, word := range words {
+			ranks[name] = append(ranks[name], wordRank{Word: word, Rank: idx + 1})
+			if len(word) > maxLength {
+				maxLength = len(word)
+			}
+		}
+	}
+
+	if f, err := os.Create(genPath); err != nil {
+		log.Fatal(err)
+	} else {
+		defer f.Close()
+		packageTemplate.Execute(f, struct {
+			Timestamp time.Time
+			JSON      []string
+			RANKS     map[string][]wordRank
+			MaxLength int
+		}{
+			Timestamp: time.Now(),
+			JSON:      jsonPaths,
+			RANKS:     ranks,
+			MaxLength: maxLength,
+		})
+	}
+
+}
@@ -1,8 +1,13 @@
 module github.com/emetriq/gourltokenizer
 
-go 1.17
+go 1.23.0
 
-require github.com/stretchr/testify v1.8.0
+require (
+	github.com/blevesearch/snowballstem v0.9.0
+	github.com/stretchr/testify v1.8.0
+	golang.org/x/net v0.43.0
+	golang.org/x/text v0.28.0
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
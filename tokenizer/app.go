@@ -0,0 +1,92 @@
+package tokenizer
+
+import "strings"
+
+// genericAppLabels are labels of app bundle IDs that say nothing about the app
+var genericAppLabels = map[string]bool{
+	"android": true,
+	"app":     true,
+	"apps":    true,
+	"ios":     true,
+	"mobile":  true,
+}
+
+// appID returns the bounds of the app bundle ID or store ID the URL str refers
+// to, or -1, -1 if it refers to none. The bundle ID is the host of
+// android-app:// deep links and the id query param of Play Store links, the
+// store ID is the last path segment of App Store links, e.g. id553834731.
+func appID(str, scheme string, kind schemeKind, hostStart, hostEnd, pathStart int) (int, int) {
+	if kind == schemeApp {
+		if isBundleID(str[hostStart:hostEnd]) {
+			return hostStart, hostEnd
+		}
+		return -1, -1
+	}
+
+	host := str[hostStart:hostEnd]
+	if host != "play.google.com" && host != "apps.apple.com" && host != "itunes.apple.com" && scheme != "market" {
+		return -1, -1
+	}
+	pathEnd := indexAnyFrom(str, pathStart, "?#")
+	path := str[pathStart:pathEnd]
+	switch {
+	case host == "play.google.com" && path == "/store/apps/details" || scheme == "market" && host == "details":
+		if pathEnd == len(str) || str[pathEnd] != '?' {
+			break
+		}
+		queryEnd := indexAnyFrom(str, pathEnd, "#")
+		for start := pathEnd + 1; start < queryEnd; {
+			end := indexAnyFrom(str[:queryEnd], start, "&")
+			if strings.HasPrefix(str[start:end], "id=") && isBundleID(str[start+3:end]) {
+				return start + 3, end
+			}
+			start = end + 1
+		}
+	case host == "apps.apple.com" || host == "itunes.apple.com":
+		start := pathStart + strings.LastIndexByte(path, '/') + 1
+		if pathEnd-start > 2 && str[start:start+2] == "id" && isDigits(str[start+2:pathEnd]) {
+			return start, pathEnd
+		}
+	}
+	return -1, -1
+}
+
+// isBundleID reports if s is a reversed domain name like com.spiegel.android
+// with at least two labels of letters, digits and '_' starting with a letter
+func isBundleID(s string) bool {
+	labels := 0
+	for idx := 0; idx <= len(s); idx++ {
+		if idx < len(s) && s[idx] != '.' {
+			b := s[idx]
+			first := idx == 0 || s[idx-1] == '.'
+			if !(b >= 'a' && b <= 'z' || !first && (b >= '0' && b <= '9' || b == '_')) {
+				return false
+			}
+			continue
+		}
+		if idx == 0 || s[idx-1] == '.' {
+			return false
+		}
+		labels++
+	}
+	return labels > 1
+}
+
+// scanAppLabels passes the words of the labels of the bundle ID str[from:to]
+// to yield, except for its top level domain and generic labels like android
+func (t *Tokenizer) scanAppLabels(str string, from, to int, yield func(Token) bool) bool {
+	segment := 0
+	start := from
+	for idx := from; idx <= to; idx++ {
+		if idx < to && str[idx] != '.' {
+			continue
+		}
+		if segment > 0 && !genericAppLabels[str[start:idx]] &&
+			!t.scanWords(str, "", start, idx, KindAppLabel, segment, yield) {
+			return false
+		}
+		segment++
+		start = idx + 1
+	}
+	return true
+}
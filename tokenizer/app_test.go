@@ -0,0 +1,24 @@
+package tokenizer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_isBundleID(t *testing.T) {
+	for s, expected := range map[string]bool{
+		"com.spiegel.android":  true,
+		"com.king.candycrush":  true,
+		"de.sport1.app_v2":     true,
+		"spiegel":              false,
+		"com..spiegel":         false,
+		".com.spiegel":         false,
+		"com.spiegel.":         false,
+		"com.1und1.mail":       false,
+		"com.spiegel-online.x": false,
+		"":                     false,
+	} {
+		assert.Equal(t, expected, isBundleID(s), s)
+	}
+}
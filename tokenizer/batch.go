@@ -0,0 +1,52 @@
+package tokenizer
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// TokenizeBatch tokenizes urls like Tokenize with up to workers goroutines and
+// returns the terms in the order of urls. The package level settings are read
+// once before the workers start.
+func TokenizeBatch(ctx context.Context, urls []string, workers int, stopwordfunc ...func(string) bool) ([][]string, error) {
+	t := defaultTokenizer(stopwordfunc...)
+	return t.TokenizeBatch(ctx, urls, workers)
+}
+
+// TokenizeBatch tokenizes urls like Tokenize with up to workers goroutines and
+// returns the terms in the order of urls. Values of workers below 1 use
+// GOMAXPROCS goroutines. If ctx is done before all URLs are tokenized, the
+// terms of the remaining URLs are nil and ctx.Err() is returned.
+func (t *Tokenizer) TokenizeBatch(ctx context.Context, urls []string, workers int) ([][]string, error) {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(urls) {
+		workers = len(urls)
+	}
+
+	results := make([][]string, len(urls))
+	var next, done atomic.Int64
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				idx := int(next.Add(1) - 1)
+				if idx >= len(urls) {
+					return
+				}
+				results[idx] = t.Tokenize(urls[idx])
+				done.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	if int(done.Load()) < len(urls) {
+		return results, ctx.Err()
+	}
+	return results, nil
+}
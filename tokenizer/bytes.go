@@ -0,0 +1,100 @@
+package tokenizer
+
+import (
+	"unicode"
+	"unicode/utf8"
+	"unsafe"
+)
+
+// TokenizeBytes works like Tokenize for a URL given as bytes. The URL is lower
+// cased and unescaped into scratch, which is grown as needed and returned for
+// reuse. The terms are appended to dst as sub-slices of scratch, so they are
+// only valid until scratch is reused. The strings passed to stop word, stem,
+// lexicon and word rank functions share memory with encodedURL and scratch
+// as well, they must not be retained after the function returns.
+func TokenizeBytes(dst [][]byte, scratch []byte, encodedURL []byte, stopwordfunc ...func(string) bool) ([][]byte, []byte) {
+	t := defaultTokenizer(stopwordfunc...)
+	return t.TokenizeBytes(dst, scratch, encodedURL)
+}
+
+// TokenizeBytes works like Tokenize for a URL given as bytes. The URL is lower
+// cased and unescaped into scratch, which is grown as needed and returned for
+// reuse. The terms are appended to dst as sub-slices of scratch, so they are
+// only valid until scratch is reused. The strings passed to stop word, stem,
+// lexicon and word rank functions share memory with encodedURL and scratch
+// as well, they must not be retained after the function returns.
+func (t *Tokenizer) TokenizeBytes(dst [][]byte, scratch []byte, encodedURL []byte) ([][]byte, []byte) {
+	// the string is only read while encodedURL can't change
+	str, _ := t.truncate(unsafe.String(unsafe.SliceData(encodedURL), len(encodedURL)))
+	scratch = appendNormalized(scratch[:0], str, true)
+	size := len(scratch)
+	if t.camelCase {
+		// the URL before lower casing follows the lower case URL
+		scratch = appendNormalized(scratch, str, false)
+	}
+
+	str = unsafe.String(unsafe.SliceData(scratch), size)
+	if t.checkScheme(str) != nil {
+		return dst, scratch
+	}
+	cased := t.casedURL(str, unsafe.String(unsafe.SliceData(scratch[size:]), len(scratch)-size))
+	t.each(str, cased, func(tok Token) bool {
+		if tok.Value != str[tok.Start:tok.End] {
+			// normalized words are not part of the URL
+			dst = append(dst, []byte(tok.Value))
+			return true
+		}
+		dst = append(dst, scratch[tok.Start:tok.End:tok.End])
+		return true
+	})
+	return dst, scratch
+}
+
+// appendNormalized appends the unescaped and, if lower is set, lower cased form
+// of encodedURL to dst, the same way Tokenize normalizes URLs
+func appendNormalized(dst []byte, encodedURL string, lower bool) []byte {
+	start := len(dst)
+	isASCII := true
+	decode := stringContainsByteChar(encodedURL, '%') && invalidEscapeOffset(encodedURL) < 0
+	for i := 0; i < len(encodedURL); i++ {
+		b := encodedURL[i]
+		if decode {
+			switch b {
+			case '%':
+				b = unhex(encodedURL[i+1])<<4 | unhex(encodedURL[i+2])
+				i += 2
+			case '+':
+				b = ' '
+			}
+		}
+		if b >= 'A' && b <= 'Z' && lower {
+			b += 'a' - 'A'
+		} else if b >= utf8.RuneSelf {
+			isASCII = false
+		}
+		dst = append(dst, b)
+	}
+	if isASCII || !lower {
+		return dst
+	}
+
+	// lower case runes behind the decoded URL and move them to its start
+	end := len(dst)
+	for i := start; i < end; {
+		r, size := utf8.DecodeRune(dst[i:end])
+		dst = utf8.AppendRune(dst, unicode.ToLower(r))
+		i += size
+	}
+	return append(dst[:start], dst[end:]...)
+}
+
+func unhex(b byte) byte {
+	switch {
+	case b >= '0' && b <= '9':
+		return b - '0'
+	case b >= 'a' && b <= 'f':
+		return b - 'a' + 10
+	default:
+		return b - 'A' + 10
+	}
+}
@@ -0,0 +1,63 @@
+package tokenizer
+
+import "strings"
+
+// linkingElements may join the parts of German compounds, like the s in
+// arbeitsmarkt
+var linkingElements = [...]string{"es", "s", "en", "n"}
+
+// minCompoundPart is the min length of a part of a compound in bytes. Short
+// words like rat or hof start too many words that aren't compounds of them,
+// like rathaus or hofmann.
+const minCompoundPart = 4
+
+// splitCompound returns the parts of word with the fewest parts that are all
+// known to lexicon, or nil if word is no compound of at least two parts. Parts
+// are at least minCompoundPart and at most maxPart bytes long, which keeps long
+// words linear.
+func splitCompound(word string, lexicon func(string) bool, maxPart int) []wordPart {
+	if len(word) < 2*minCompoundPart {
+		return nil
+	}
+	// parts[i] is the number of parts of the best split of word up to the
+	// part starting at i, last[i] the part in front of it
+	parts := make([]int, len(word)+1)
+	last := make([]wordPart, len(word)+1)
+	for i := range parts {
+		parts[i] = -1
+	}
+	parts[0] = 0
+
+	for start := 0; start < len(word); start++ {
+		if parts[start] < 0 {
+			continue
+		}
+		for end := start + minCompoundPart; end <= min(start+maxPart, len(word)); end++ {
+			if !lexicon(word[start:end]) {
+				continue
+			}
+			relax := func(next int) {
+				if parts[next] < 0 || parts[start]+1 < parts[next] {
+					parts[next] = parts[start] + 1
+					last[next] = wordPart{start, end}
+				}
+			}
+			relax(end)
+			for _, link := range linkingElements {
+				if strings.HasPrefix(word[end:], link) && end+len(link) < len(word) {
+					relax(end + len(link))
+				}
+			}
+		}
+	}
+	if parts[len(word)] < 2 {
+		return nil
+	}
+
+	result := make([]wordPart, parts[len(word)])
+	for i, n := len(result)-1, len(word); i >= 0; i-- {
+		result[i] = last[n]
+		n = last[n].start
+	}
+	return result
+}
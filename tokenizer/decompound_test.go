@@ -0,0 +1,31 @@
+package tokenizer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_splitCompound(t *testing.T) {
+	for word, expected := range map[string][]string{
+		"fussballbundesliga": {"fussball", "bundesliga"},
+		"motorschaden":       {"motor", "schaden"},
+		"arbeitsmarkt":       {"arbeit", "markt"},
+		"kinderzimmerlampe":  {"kinder", "zimmer", "lampe"},
+		"straßenbahn":        {"straße", "bahn"},
+		"fussball":           nil,
+		"motorxschaden":      nil,
+		"motors":             nil,
+		"":                   nil,
+		// short parts are not split off
+		"rathaus":  nil,
+		"hofmann":  nil,
+		"webseite": nil,
+	} {
+		var parts []string
+		for _, p := range splitCompound(word, IsGermanCompoundPart, maxCompoundPartLength) {
+			parts = append(parts, word[p.start:p.end])
+		}
+		assert.Equal(t, expected, parts, word)
+	}
+}
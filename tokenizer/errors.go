@@ -0,0 +1,59 @@
+package tokenizer
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidEscape is reported for a '%' not followed by two hex digits.
+	// The URL is tokenized without unescaping.
+	ErrInvalidEscape = errors.New("invalid escape")
+	// ErrTooLong is reported for URLs longer than the max length. Only the
+	// first max length bytes are tokenized.
+	ErrTooLong = errors.New("url too long")
+	// ErrUnsupportedScheme is reported for URLs with a scheme not enabled by
+	// WithSchemes. Such URLs are not tokenized at all.
+	ErrUnsupportedScheme = errors.New("unsupported scheme")
+)
+
+// Error describes why a URL could not be tokenized completely. Use errors.Is
+// to check for ErrInvalidEscape, ErrTooLong or ErrUnsupportedScheme.
+type Error struct {
+	// Err is the reason
+	Err error
+	// Offset is the byte offset in the URL the problem was found at
+	Offset int
+	// Text is the offending part of the URL, if any
+	Text string
+}
+
+func (e *Error) Error() string {
+	if e.Text == "" {
+		return fmt.Sprintf("tokenizer: %v at offset %d", e.Err, e.Offset)
+	}
+	return fmt.Sprintf("tokenizer: %v %q at offset %d", e.Err, e.Text, e.Offset)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func isHex(b byte) bool {
+	return b >= '0' && b <= '9' || b >= 'a' && b <= 'f' || b >= 'A' && b <= 'F'
+}
+
+// invalidEscapeOffset returns the offset of the first '%' in s that is not
+// followed by two hex digits or -1 if all escapes are valid
+func invalidEscapeOffset(s string) int {
+	for i := 0; i < len(s); i++ {
+		if s[i] != '%' {
+			continue
+		}
+		if i+2 >= len(s) || !isHex(s[i+1]) || !isHex(s[i+2]) {
+			return i
+		}
+		i += 2
+	}
+	return -1
+}
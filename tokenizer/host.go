@@ -0,0 +1,268 @@
+package tokenizer
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+// DefaultHostPrefixes are the subdomains that are commonly used for the same
+// site as the bare domain, see WithHostPrefixes.
+var DefaultHostPrefixes = []string{"www", "m", "mobile", "amp"}
+
+// HostForm selects the form of internationalized host names.
+type HostForm uint8
+
+const (
+	// HostUnicode decodes punycode labels, e.g. xn--mnchen-3ya.de to münchen.de
+	HostUnicode HostForm = 1 << iota
+	// HostASCII encodes Unicode labels as punycode, e.g. münchen.de to
+	// xn--mnchen-3ya.de
+	HostASCII
+)
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// toUnicode decodes the punycode labels of host. Invalid labels are kept.
+func toUnicode(host string) string {
+	if !strings.Contains(host, "xn--") {
+		return host
+	}
+	var b strings.Builder
+	b.Grow(len(host))
+	start := 0
+	for idx := 0; idx <= len(host); idx++ {
+		if idx < len(host) && host[idx] != '.' {
+			continue
+		}
+		b.WriteString(labelToUnicode(host[start:idx]))
+		if idx < len(host) {
+			b.WriteByte('.')
+		}
+		start = idx + 1
+	}
+	return b.String()
+}
+
+// labelToUnicode decodes the punycode label. Labels that decode to nothing or
+// to runes not allowed in host names, like xn-- or xn--a, are kept.
+func labelToUnicode(label string) string {
+	if !strings.HasPrefix(label, "xn--") {
+		return label
+	}
+	if decoded, err := idna.Lookup.ToUnicode(label); err == nil && decoded != "" {
+		return decoded
+	}
+	return label
+}
+
+// toASCII encodes the Unicode labels of host as punycode
+func toASCII(host string) string {
+	if isASCII(host) {
+		return host
+	}
+	if encoded, err := idna.Punycode.ToASCII(host); err == nil {
+		return encoded
+	}
+	return host
+}
+
+// hostForm returns host or label in the configured form, with both forms
+// enabled that is the Unicode form
+func (t *Tokenizer) hostForm(host string) string {
+	switch {
+	case t.hostForms&HostUnicode != 0:
+		return toUnicode(host)
+	case t.hostForms&HostASCII != 0:
+		return toASCII(host)
+	}
+	return host
+}
+
+// hostName returns the offsets of the host name in the authority str[from:to],
+// without userinfo, port and trailing dots. Brackets are only kept around IPv6
+// addresses, an opening bracket that is never closed leaves no host name.
+func hostName(str string, from, to int) (int, int) {
+	authority := str[from:to]
+	if strings.IndexAny(authority, "@:[") < 0 && !strings.HasSuffix(authority, ".") {
+		return from, to
+	}
+
+	// user info must never become a term
+	if idx := strings.LastIndexByte(authority, '@'); idx >= 0 {
+		from += idx + 1
+	}
+	if from < to && str[from] == '[' {
+		end := indexAnyFrom(str[:to], from, "]")
+		if end == to {
+			// without the closing bracket there is no telling where a port
+			// could start
+			return to, to
+		}
+		if _, _, _, ok := parseIPHost(str[from:end+1], false); ok {
+			return from, end + 1
+		}
+		to = end
+		from++
+	}
+	// an empty port is removed, too
+	if idx := strings.LastIndexByte(str[from:to], ':'); idx >= 0 && (from+idx+1 == to || isDigits(str[from+idx+1:to])) {
+		to = from + idx
+	}
+	for to > from && str[to-1] == '.' {
+		to--
+	}
+	return from, to
+}
+
+// countHostPrefixes returns the number of leading labels of host in front of
+// the registrable domain with label domainLabel that are host prefixes
+func (t *Tokenizer) countHostPrefixes(host string, domainLabel int) int {
+	count := 0
+	for start := labelStart(host, 0); count < domainLabel; count++ {
+		end := indexAnyFrom(host, start, ".")
+		if !t.isHostPrefix(host[start:end]) {
+			break
+		}
+		start = labelStart(host[end:], 0) + end
+	}
+	return count
+}
+
+// isHostPrefix reports if label is one of the host prefixes, optionally
+// followed by digits like in www2
+func (t *Tokenizer) isHostPrefix(label string) bool {
+	for _, prefix := range t.hostPrefixes {
+		if !strings.HasPrefix(label, prefix) {
+			continue
+		}
+		// the prefix itself, like www, or followed by a number, like www2
+		if number := label[len(prefix):]; number == "" || isDigits(number) {
+			return true
+		}
+	}
+	return false
+}
+
+// registrableLabel returns the index of the first label of the registrable
+// domain of the lower case host or -1 if it has none
+func registrableLabel(host string) int {
+	// the public suffix list is in punycode
+	host = toASCII(host)
+	start := registrableDomainStart(host)
+	if start < 0 {
+		return -1
+	}
+	return countHostLabels(host[:start])
+}
+
+// labelStart returns the offset of the label with index n in host
+func labelStart(host string, n int) int {
+	for idx := 0; idx < len(host); idx++ {
+		if host[idx] != '.' && (idx == 0 || host[idx-1] == '.') {
+			if n == 0 {
+				return idx
+			}
+			n--
+		}
+	}
+	return len(host)
+}
+
+// countHostLabels returns the number of non empty dot separated labels of host
+func countHostLabels(host string) int {
+	labels := 0
+	start := 0
+	for idx := 0; idx <= len(host); idx++ {
+		if idx < len(host) && host[idx] != '.' {
+			continue
+		}
+		if idx > start {
+			labels++
+		}
+		start = idx + 1
+	}
+	return labels
+}
+
+// scanHostLabels passes the first count labels of the host str[from:to], or
+// their parts if splitting is enabled, to yield
+func (t *Tokenizer) scanHostLabels(str string, from, to, count int, yield func(Token) bool) bool {
+	segment := 0
+	start := from
+	for idx := from; idx <= to && segment < count; idx++ {
+		if idx < to && str[idx] != '.' {
+			continue
+		}
+		if idx > start {
+			label := t.hostForm(str[start:idx])
+			if !t.emitSplit(str, Token{Value: label, Kind: KindHostLabel, Segment: segment, Start: start, End: idx}, yield) {
+				return false
+			}
+			segment++
+		}
+		start = idx + 1
+	}
+	return true
+}
+
+// scanHost passes the enabled host name kinds of the host str[from:to] to
+// yield: the host name without leading dots, if both host forms are enabled
+// and differ in both forms, followed by the suffixes of the host, its
+// registrable domain and brand. domainLabel is the index of the first label of
+// the registrable domain or -1.
+func (t *Tokenizer) scanHost(str string, from, to, domainLabel int, yield func(Token) bool) bool {
+	if t.kinds&(1<<KindHost) != 0 && to-from > 3 { // if domain name is longer than 3 chars
+		start := from
+		for start < to && str[start] == '.' {
+			start++
+		}
+		host := str[start:to]
+		name := t.hostForm(host)
+		if !yield(Token{Value: name, Kind: KindHost, Start: start, End: to}) {
+			return false
+		}
+		if t.hostForms == HostUnicode|HostASCII {
+			if ascii := toASCII(host); ascii != name && !yield(Token{Value: ascii, Kind: KindHost, Start: start, End: to}) {
+				return false
+			}
+		}
+	}
+	if domainLabel < 0 {
+		return true
+	}
+
+	host := str[from:to]
+	if t.kinds&(1<<KindHostSuffix) != 0 {
+		last := domainLabel
+		if t.kinds&(1<<KindDomain) != 0 {
+			// the registrable domain is emitted as KindDomain
+			last--
+		}
+		for label := 1; label <= last; label++ {
+			start := from + labelStart(host, label)
+			if !yield(Token{Value: t.hostForm(str[start:to]), Kind: KindHostSuffix, Segment: label, Start: start, End: to}) {
+				return false
+			}
+		}
+	}
+
+	start := from + labelStart(host, domainLabel)
+	if t.kinds&(1<<KindDomain) != 0 &&
+		!yield(Token{Value: t.hostForm(str[start:to]), Kind: KindDomain, Segment: domainLabel, Start: start, End: to}) {
+		return false
+	}
+	if t.kinds&(1<<KindBrand) != 0 {
+		end := indexAnyFrom(str[:to], start, ".")
+		return t.emitSplit(str, Token{Value: t.hostForm(str[start:end]), Kind: KindBrand, Segment: domainLabel, Start: start, End: end}, yield)
+	}
+	return true
+}
@@ -0,0 +1,227 @@
+package tokenizer
+
+import "math/bits"
+
+// sessionKeys are the names of URL params whose values identify a session or
+// click instead of content
+var sessionKeys = map[string]bool{
+	"aspsessionid": true,
+	"cfid":         true,
+	"cftoken":      true,
+	"dclid":        true,
+	"fbclid":       true,
+	"gclid":        true,
+	"jsessionid":   true,
+	"msclkid":      true,
+	"phpsessid":    true,
+	"sessid":       true,
+	"session":      true,
+	"session_id":   true,
+	"sessionid":    true,
+	"sid":          true,
+	"token":        true,
+}
+
+// minHexID is the min length of a hex hash, so that words like "cafe" are kept
+const minHexID = 8
+
+// minHexDigits is the min number of distinct digits of a hex hash, so that
+// runs like "aaaaaaaa" are kept
+const minHexDigits = 4
+
+// minBlobID is the min length of a base62 or base64 blob, the length of a
+// YouTube video ID like dQw4w9WgXcQ
+const minBlobID = 11
+
+func isHexLower(b byte) bool {
+	return b >= '0' && b <= '9' || b >= 'a' && b <= 'f'
+}
+
+// uuidEnd returns the end of the UUID like 5eb1c098-cb95-4f49-8038-5eb1c098e652
+// starting at from in the lower case str[:to], or -1 if there is none
+func uuidEnd(str string, from, to int) int {
+	end := from + 36
+	if end > to || end < to && isAlnum(str[end]) {
+		return -1
+	}
+	for idx := from; idx < end; idx++ {
+		switch idx - from {
+		case 8, 13, 18, 23:
+			if str[idx] != '-' {
+				return -1
+			}
+		default:
+			if !isHexLower(str[idx]) {
+				return -1
+			}
+		}
+	}
+	return end
+}
+
+func isAlnum(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= '0' && b <= '9'
+}
+
+// isHexHash reports if a hex word of length with the set of digits is a hash:
+// it has at least minHexID chars and minHexDigits distinct digits, like
+// d41d8cd9 or deadbeef
+func isHexHash(length int, digits uint16) bool {
+	return length >= minHexID && bits.OnesCount16(digits) >= minHexDigits
+}
+
+// mayContainID reports if the lower case s has a digit, a '=' of a session
+// param or a run of hex letters long enough for a hash. Only then it can
+// contain an ID.
+func mayContainID(s string) bool {
+	run := 0
+	for i := 0; i < len(s); i++ {
+		b := s[i]
+		switch {
+		case b >= '0' && b <= '9' || b == '=':
+			return true
+		case b >= 'a' && b <= 'f':
+			if run++; run >= minHexID {
+				return true
+			}
+		default:
+			run = 0
+		}
+	}
+	return false
+}
+
+// isID reports if the lower case word is a hex hash or a random looking blob
+// of at least minBlobID letters and digits that change at least three times
+func isID(word string) bool {
+	if len(word) < minHexID {
+		return false
+	}
+	hex := true
+	var digits uint16
+	changes := 0
+	for idx := 0; idx < len(word); idx++ {
+		b := word[idx]
+		if !isAlnum(b) {
+			return false
+		}
+		if hex = hex && isHexLower(b); hex {
+			digits |= 1 << unhex(b)
+		}
+		if idx > 0 && (b <= '9') != (word[idx-1] <= '9') {
+			changes++
+		}
+	}
+	return hex && isHexHash(len(word), digits) || len(word) >= minBlobID && changes >= 3
+}
+
+// segmentID classifies the segment of the lower case str[:to] starting at
+// from, which ends at '/', '&', ';', '=' or in front of a file extension. If
+// the segment is a UUID, a hex hash or a base64 or base64url blob, whose
+// pieces between '-', '_' and '+' are mostly random looking, it returns the
+// bounds of the segment. For a session param it returns the bounds of the
+// value, so that the key is skipped, too. Otherwise it returns -1, -1 and if
+// a piece of the segment looks like an ID, so that its words have to be
+// checked.
+func segmentID(str string, from, to int) (int, int, bool) {
+	// IDs contain digits or are hex hashes, so most segments are done after
+	// a quick look
+	end := from
+	for end < to && (str[end] >= 'a' && str[end] <= 'z' || str[end] == '-' || str[end] == '_' || str[end] == '+') {
+		end++
+	}
+	if end == to || str[end] < '0' || str[end] > '9' {
+		if end < to && str[end] == '=' && sessionKeys[str[from:end]] {
+			return end + 1, indexAnyFrom(str[:to], end+1, "&;/"), false
+		}
+		if !mayContainID(str[from:end]) {
+			return -1, -1, false
+		}
+	}
+
+	single, pieceHex := true, true
+	var digits uint16
+	pieceStart, changes, random := from, 0, 0
+	for end = from; end < to; end++ {
+		b := str[end]
+		if b == '-' || b == '_' || b == '+' {
+			random += randomPiece(end-pieceStart, pieceHex, digits, changes)
+			single, pieceHex, digits, pieceStart, changes = false, true, 0, end+1, 0
+			continue
+		}
+		if !isAlnum(b) {
+			break
+		}
+		if pieceHex = pieceHex && isHexLower(b); pieceHex {
+			digits |= 1 << unhex(b)
+		}
+		if end > pieceStart && (b <= '9') != (str[end-1] <= '9') {
+			changes++
+		}
+	}
+	random += randomPiece(end-pieceStart, pieceHex, digits, changes)
+	if end < to {
+		switch str[end] {
+		case '=':
+			if sessionKeys[str[from:end]] {
+				return end + 1, indexAnyFrom(str[:to], end+1, "&;/"), false
+			}
+		case '/', '&', ';', '.':
+		default:
+			return -1, -1, random > 0
+		}
+	}
+	length := end - from
+	if length == 36 && uuidEnd(str, from, to) == end ||
+		single && pieceHex && isHexHash(length, digits) ||
+		length >= minBlobID && 2*random >= length {
+		return from, paddingEnd(str, end, to), false
+	}
+	return -1, -1, random > 0
+}
+
+// randomPiece returns the length of a piece of a segment if it looks like a
+// part of an ID, either hex with at least minHexDigits distinct digits or with
+// letters and digits that change at least three times, otherwise 0
+func randomPiece(length int, hex bool, digits uint16, changes int) int {
+	if hex && isHexHash(length, digits) || length >= minHexID && changes >= 3 {
+		return length
+	}
+	return 0
+}
+
+// paddingEnd returns the end of the base64 padding starting at end of the
+// lower case str[:to], if it ends the segment
+func paddingEnd(str string, end, to int) int {
+	idx := end
+	for idx < to && idx < end+2 && str[idx] == '=' {
+		idx++
+	}
+	if idx == end || idx < to && !stringContainsByteChar("&;/", str[idx]) {
+		return end
+	}
+	return idx
+}
+
+// skipID passes the ID at the start of the segment str[from:to] to yield, see
+// segmentID. It returns where to continue the scan and if the words of the
+// segment have to be checked for IDs, ok is false if yield stopped the
+// iteration.
+func (t *Tokenizer) skipID(str string, from, to, segment int, yield func(Token) bool) (next int, checkIDs, ok bool) {
+	start, end, hasIDs := segmentID(str, from, to)
+	if end < 0 {
+		return from, hasIDs, true
+	}
+	if end > start && !t.emitID(str, start, end, segment, yield) {
+		return end, false, false
+	}
+	return end, false, true
+}
+
+// emitID passes the ID str[start:end] to yield if IDs are enabled
+func (t *Tokenizer) emitID(str string, start, end, segment int, yield func(Token) bool) bool {
+	if t.kinds&(1<<KindID) == 0 {
+		return true
+	}
+	return yield(Token{Value: str[start:end], Kind: KindID, Segment: segment, Start: start, End: end})
+}
@@ -0,0 +1,72 @@
+package tokenizer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_isID(t *testing.T) {
+	for word, expected := range map[string]bool{
+		"d41d8cd9":                         true,
+		"cafebabe":                         true,
+		"deadbeef":                         true,
+		"dqw4w9wgxcq":                      true,
+		"facade":                           false,
+		"b82ebced":                         true,
+		"d41d8cd98f00b204e9800998ecf8427e": true,
+		"agvsbg8gd29ybgq9x":                true,
+		"3q2796gxk4zyb1mn":                 true,
+		"cafe":                             false,
+		"aaaaaaaaaaaa":                     false,
+		"fussballbundesliga":               false,
+		"autoscout24":                      false,
+		"covid19impfstoff":                 false,
+		"münchen":                          false,
+	} {
+		assert.Equal(t, expected, isID(word), word)
+	}
+}
+
+func Test_segmentID(t *testing.T) {
+	for str, expected := range map[string]string{
+		"d41d8cd9":                             "d41d8cd9",
+		"d41d8cd9.jpg":                         "d41d8cd9",
+		"b82ebced-cb95-4f49-8038-5eb1c098e652": "b82ebced-cb95-4f49-8038-5eb1c098e652",
+		"agvsbg8gd29ybgqgdghpcybpcybh+ymxvyg":  "agvsbg8gd29ybgqgdghpcybpcybh+ymxvyg",
+		"zxhhbxbszs1ibg9i_dghpcy1pcy1h/x":      "zxhhbxbszs1ibg9i_dghpcy1pcy1h",
+		"agvsbg8gd29ybgqgdghpcw==&x":           "agvsbg8gd29ybgqgdghpcw==",
+		"jsessionid=abc12def;x":                "abc12def",
+		"sid=":                                 "",
+		"fussball-bundesliga":                  "-",
+		"iphone-15-pro-max-test-2023":          "-",
+		"mp3-player-ps5-xbox360-vergleich":     "-",
+		"seat-leon-grau-5eb1c098e652":          "-",
+		"d41d8cd9,cafe":                        "-",
+		"deadbeef":                             "deadbeef",
+		"dqw4w9wgxcq":                          "dqw4w9wgxcq",
+		"deadbeef-apfelkuchen":                 "-",
+	} {
+		start, end, _ := segmentID(str, 0, len(str))
+		if expected == "-" {
+			assert.Equal(t, -1, end, str)
+			continue
+		}
+		if !assert.Greater(t, end, -1, str) {
+			continue
+		}
+		assert.Equal(t, expected, str[start:end], str)
+	}
+	_, _, hasIDs := segmentID("seat-leon-grau-5eb1c098e652", 0, 27)
+	assert.True(t, hasIDs)
+	_, _, hasIDs = segmentID("fussball-bundesliga", 0, 19)
+	assert.False(t, hasIDs)
+}
+
+func Test_uuidEnd(t *testing.T) {
+	str := "grau-b82ebced-cb95-4f49-8038-5eb1c098e652"
+	assert.Equal(t, len(str), uuidEnd(str, 5, len(str)))
+	assert.Equal(t, -1, uuidEnd(str, 0, len(str)))
+	assert.Equal(t, -1, uuidEnd(str+"0", 5, len(str)+1))
+	assert.Equal(t, -1, uuidEnd(str, 5, len(str)-1))
+}
@@ -0,0 +1,131 @@
+package tokenizer
+
+import (
+	"net/netip"
+	"strings"
+)
+
+// parseIPHost parses host as IP address. Bracketed IPv6 addresses and dotted
+// IPv4 addresses are always recognized, IPv4 addresses with less than four
+// parts like decimal or hex encoded ones only if shorthand is true. A port
+// behind the address is ignored. start and end are the offsets of the address
+// in host.
+func parseIPHost(host string, shorthand bool) (addr netip.Addr, start, end int, ok bool) {
+	// all IPv4 forms start with a digit, so host names are done quickly
+	if host == "" || host[0] != '[' && (host[0] < '0' || host[0] > '9') {
+		return addr, 0, 0, false
+	}
+	if strings.HasPrefix(host, "[") {
+		end = strings.IndexByte(host, ']')
+		if end < 0 {
+			return addr, 0, 0, false
+		}
+		addr, err := netip.ParseAddr(host[1:end])
+		if err != nil || !addr.Is6() {
+			return addr, 0, 0, false
+		}
+		return addr.WithZone(""), 1, end, true
+	}
+
+	end = strings.IndexByte(host, ':')
+	if end < 0 {
+		end = len(host)
+	} else if port := host[end+1:]; port != "" && !isDigits(port) {
+		return addr, 0, 0, false
+	}
+	addr, ok = parseIPv4(host[:end], shorthand)
+	return addr, 0, end, ok
+}
+
+// isDigits reports if s is not empty and consists of digits only
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// parseIPv4 parses an IPv4 address like browsers do: each of up to four dot
+// separated parts is a decimal, hex (0x) or octal (leading 0) number and the
+// last part fills the remaining bytes, e.g. 3232235521 or 0xc0.0xa8.1 are
+// 192.168.0.1. Addresses with less than four parts are only accepted if
+// shorthand is true.
+func parseIPv4(host string, shorthand bool) (netip.Addr, bool) {
+	host = strings.TrimSuffix(host, ".")
+	var parts [4]uint64
+	n := 0
+	for start := 0; start <= len(host); n++ {
+		end := indexAnyFrom(host, start, ".")
+		if n == len(parts) {
+			return netip.Addr{}, false
+		}
+		value, ok := parseIPv4Part(host[start:end])
+		if !ok {
+			return netip.Addr{}, false
+		}
+		parts[n] = value
+		start = end + 1
+	}
+	if n < 4 && !shorthand {
+		return netip.Addr{}, false
+	}
+
+	var ip uint64
+	for i := 0; i < n-1; i++ {
+		if parts[i] > 255 {
+			return netip.Addr{}, false
+		}
+		ip = ip<<8 | parts[i]
+	}
+	lastBits := uint(8 * (5 - n))
+	if parts[n-1] >= 1<<lastBits {
+		return netip.Addr{}, false
+	}
+	ip = ip<<lastBits | parts[n-1]
+	return netip.AddrFrom4([4]byte{byte(ip >> 24), byte(ip >> 16), byte(ip >> 8), byte(ip)}), true
+}
+
+// parseIPv4Part parses a decimal, hex or octal part of an IPv4 address
+func parseIPv4Part(part string) (uint64, bool) {
+	base := uint64(10)
+	switch {
+	case strings.HasPrefix(part, "0x") || strings.HasPrefix(part, "0X"):
+		part = part[2:]
+		base = 16
+		if part == "" {
+			return 0, true
+		}
+	case len(part) > 1 && part[0] == '0':
+		part = part[1:]
+		base = 8
+	}
+	if part == "" {
+		return 0, false
+	}
+
+	var value uint64
+	for i := 0; i < len(part); i++ {
+		digit := digitValue(part[i])
+		if digit >= base {
+			return 0, false
+		}
+		value = value*base + digit
+		if value > 0xffffffff {
+			return 0, false
+		}
+	}
+	return value, true
+}
+
+// digitValue returns the value of the hex digit b or 16 if b is none
+func digitValue(b byte) uint64 {
+	if isHex(b) {
+		return uint64(unhex(b))
+	}
+	return 16
+}
@@ -0,0 +1,56 @@
+package tokenizer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseIPHost(t *testing.T) {
+	for host, expected := range map[string]string{
+		"192.168.0.1":        "192.168.0.1",
+		"192.168.0.1.":       "192.168.0.1",
+		"192.168.0.1:8080":   "192.168.0.1",
+		"0xc0.0xa8.0.1":      "192.168.0.1",
+		"0300.0250.0.1":      "192.168.0.1",
+		"3232235521":         "192.168.0.1",
+		"0xc0a80001":         "192.168.0.1",
+		"192.11010049":       "192.168.0.1",
+		"[2001:db8::1]":      "2001:db8::1",
+		"[2001:DB8:0::1]:80": "2001:db8::1",
+		"[::ffff:1.2.3.4]":   "::ffff:1.2.3.4",
+		"example.com":        "",
+		"1.2.3.4.5":          "",
+		"256.1.1.1":          "",
+		"1.2.3.4:http":       "",
+		"08.1.1.1":           "",
+		"[192.168.0.1]":      "",
+		"[2001:db8::1":       "",
+		"":                   "",
+	} {
+		addr, _, _, ok := parseIPHost(host, true)
+		if expected == "" {
+			assert.False(t, ok, host)
+		} else if assert.True(t, ok, host) {
+			assert.Equal(t, expected, addr.String(), host)
+		}
+	}
+}
+
+func Test_parseIPHostShorthand(t *testing.T) {
+	_, _, _, ok := parseIPHost("2021", false)
+	assert.False(t, ok)
+	_, _, _, ok = parseIPHost("0xc0a80001", false)
+	assert.False(t, ok)
+	addr, start, end, ok := parseIPHost("[2001:db8::1]:8080", false)
+	assert.True(t, ok)
+	assert.Equal(t, "2001:db8::1", addr.String())
+	assert.Equal(t, 1, start)
+	assert.Equal(t, 12, end)
+}
+
+func Test_isDigits(t *testing.T) {
+	assert.True(t, isDigits("8080"))
+	assert.False(t, isDigits(""))
+	assert.False(t, isDigits("80a"))
+}
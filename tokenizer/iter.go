@@ -0,0 +1,40 @@
+package tokenizer
+
+import "iter"
+
+// All returns an iterator over the terms of encodedURL, as returned by
+// Tokenize. The terms are produced lazily while iterating.
+func All(encodedURL string, stopwordfunc ...func(string) bool) iter.Seq[string] {
+	t := defaultTokenizer(stopwordfunc...)
+	return t.All(encodedURL)
+}
+
+// AllTokens returns an iterator over the index and token of the terms of
+// encodedURL, as returned by Tokens.
+func AllTokens(encodedURL string, stopwordfunc ...func(string) bool) iter.Seq2[int, Token] {
+	t := defaultTokenizer(stopwordfunc...)
+	return t.AllTokens(encodedURL)
+}
+
+// All returns an iterator over the terms of encodedURL, as returned by
+// Tokenize. The terms are produced lazily while iterating.
+func (t *Tokenizer) All(encodedURL string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		t.EachToken(encodedURL, yield)
+	}
+}
+
+// AllTokens returns an iterator over the index and token of the terms of
+// encodedURL, as returned by Tokens.
+func (t *Tokenizer) AllTokens(encodedURL string) iter.Seq2[int, Token] {
+	return func(yield func(int, Token) bool) {
+		idx := 0
+		t.eachToken(encodedURL, func(tok Token) bool {
+			if !yield(idx, tok) {
+				return false
+			}
+			idx++
+			return true
+		})
+	}
+}
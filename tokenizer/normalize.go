@@ -0,0 +1,58 @@
+package tokenizer
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalization selects how non ASCII words are normalized, so that words
+// written differently compare equal.
+type Normalization uint8
+
+const (
+	// NormalizeNFC composes letters and combining marks, e.g. "é" to "é"
+	NormalizeNFC Normalization = 1 << iota
+	// NormalizeNFKC composes letters and replaces compatibility characters,
+	// e.g. "ﬁ" to "fi"
+	NormalizeNFKC
+	// NormalizeCaseFold applies full Unicode case folding, e.g. "ß" to "ss"
+	NormalizeCaseFold
+	// NormalizeDiacritics removes diacritics, e.g. "müller" to "muller"
+	NormalizeDiacritics
+	// NormalizeGerman transliterates umlauts and ß to their German digraphs,
+	// e.g. "fußball" to "fussball" and "müller" to "mueller". IsGermanStopWord
+	// knows the transliterated stop words, too.
+	NormalizeGerman
+)
+
+var germanReplacer = strings.NewReplacer("ä", "ae", "ö", "oe", "ü", "ue", "ß", "ss")
+
+// normalizeWord applies the normalization of t to the word s
+func (t *Tokenizer) normalizeWord(s string) string {
+	if t.normalization == 0 || isASCII(s) {
+		return s
+	}
+	// casers and chained transformers have state, so they are not shared
+	if t.normalization&NormalizeCaseFold != 0 {
+		s = cases.Fold().String(s)
+	}
+	if t.normalization&NormalizeGerman != 0 {
+		// decomposed umlauts are composed first
+		s = germanReplacer.Replace(norm.NFC.String(s))
+	}
+	if t.normalization&NormalizeDiacritics != 0 {
+		s, _, _ = transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn))), s)
+	}
+	switch {
+	case t.normalization&NormalizeNFKC != 0:
+		s = norm.NFKC.String(s)
+	case t.normalization&(NormalizeNFC|NormalizeDiacritics) != 0:
+		s = norm.NFC.String(s)
+	}
+	return s
+}
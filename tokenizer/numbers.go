@@ -0,0 +1,80 @@
+package tokenizer
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NumberPolicy selects how words containing both letters and digits, like
+// "autoscout24", are handled.
+type NumberPolicy uint8
+
+const (
+	// NumbersDrop skips words containing digits
+	NumbersDrop NumberPolicy = iota
+	// NumbersKeep keeps words containing digits as they are, "autoscout24"
+	NumbersKeep
+	// NumbersSplit splits words at letter/digit boundaries, "autoscout24"
+	// yields "autoscout" and, if pure numbers are emitted, "24"
+	NumbersSplit
+	// NumbersStrip removes the digits of words, "covid19" yields "covid"
+	NumbersStrip
+)
+
+// isDigitRune reports if r is a decimal digit
+func isDigitRune(r rune) bool {
+	return r >= '0' && r <= '9' || r >= utf8.RuneSelf && unicode.IsDigit(r)
+}
+
+// isNumber reports if s consists of digits only
+func isNumber(s string) bool {
+	for _, r := range s {
+		if !isDigitRune(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// emitNumberWord passes the word str[start:end] containing digits to yield
+// according to the number policy. Pure numbers are only passed if enabled.
+func (t *Tokenizer) emitNumberWord(str string, start, end int, kind Kind, segment int, yield func(Token) bool) bool {
+	word := str[start:end]
+	if isNumber(word) {
+		if !t.pureNumbers {
+			return true
+		}
+		return t.emitTerm(str, word, start, end, kind, segment, yield)
+	}
+
+	switch t.numberPolicy {
+	case NumbersKeep:
+		return t.emitTerm(str, word, start, end, kind, segment, yield)
+	case NumbersSplit:
+		// runs of letters or digits
+		runStart, digits := start, false
+		for idx, r := range word {
+			isDigit := isDigitRune(r)
+			if idx > 0 && isDigit != digits {
+				if (!digits || t.pureNumbers) && !t.emitTerm(str, str[runStart:start+idx], runStart, start+idx, kind, segment, yield) {
+					return false
+				}
+				runStart = start + idx
+			}
+			digits = isDigit
+		}
+		if !digits || t.pureNumbers {
+			return t.emitTerm(str, str[runStart:end], runStart, end, kind, segment, yield)
+		}
+	case NumbersStrip:
+		stripped := strings.Map(func(r rune) rune {
+			if isDigitRune(r) {
+				return -1
+			}
+			return r
+		}, word)
+		return t.emitTerm(str, stripped, start, end, kind, segment, yield)
+	}
+	return true
+}
@@ -0,0 +1,160 @@
+package tokenizer
+
+import "strings"
+
+// Option configures a Tokenizer created by New.
+type Option func(*Tokenizer)
+
+// WithMinWordSize sets the minimum length of a path word. Values below 1 are
+// treated as 1.
+func WithMinWordSize(size int) Option {
+	return func(t *Tokenizer) {
+		if size < 1 {
+			size = 1
+		}
+		t.minWordSize = size
+	}
+}
+
+// WithStopWordFunc sets the stop word filter, e.g. IsGermanStopWord. A nil
+// func disables stop word filtering.
+func WithStopWordFunc(stopwordfunc func(string) bool) Option {
+	return func(t *Tokenizer) {
+		t.stopWordFunc = stopwordfunc
+	}
+}
+
+// WithKinds sets the kinds of tokens to emit. By default host labels, host
+// names, path and fragment words are emitted, while query parameters are
+// skipped together with everything behind them.
+func WithKinds(kinds ...Kind) Option {
+	return func(t *Tokenizer) {
+		t.kinds = 0
+		for _, kind := range kinds {
+			t.kinds |= 1 << kind
+		}
+	}
+}
+
+// WithMaxLength limits the number of bytes of a URL that are tokenized. Longer
+// URLs are cut and reported as ErrTooLong by TokenizeE. Values below 1
+// disable the limit, which is the default.
+func WithMaxLength(length int) Option {
+	return func(t *Tokenizer) {
+		t.maxLength = length
+	}
+}
+
+// WithSchemes restricts the schemes of the URLs that are tokenized, e.g.
+// "http" and "https". URLs with other schemes yield no terms and are reported
+// as ErrUnsupportedScheme by TokenizeE. URLs without scheme are always
+// tokenized. By default all schemes are accepted.
+func WithSchemes(schemes ...string) Option {
+	return func(t *Tokenizer) {
+		t.schemes = make([]string, len(schemes))
+		for i, scheme := range schemes {
+			t.schemes[i] = strings.ToLower(scheme)
+		}
+	}
+}
+
+// WithHostForm sets the form of internationalized host names and labels. With
+// both HostUnicode and HostASCII, labels are Unicode and the host name is
+// emitted in both forms. By default hosts are emitted as written in the URL.
+// The label of the registrable domain, e.g. münchen in münchen.de, is no host
+// label but a KindBrand term, see WithKinds.
+func WithHostForm(form HostForm) Option {
+	return func(t *Tokenizer) {
+		t.hostForms = form
+	}
+}
+
+// WithHostPrefixes removes the given leading subdomains, optionally followed by
+// digits, from host labels and names, so that e.g. m.spiegel.de and
+// www2.spiegel.de both yield spiegel.de. The registrable domain is never
+// removed. DefaultHostPrefixes contains common prefixes.
+func WithHostPrefixes(prefixes ...string) Option {
+	return func(t *Tokenizer) {
+		t.hostPrefixes = make([]string, len(prefixes))
+		for i, prefix := range prefixes {
+			t.hostPrefixes[i] = strings.ToLower(prefix)
+		}
+	}
+}
+
+// WithNormalization normalizes non ASCII path, query, fragment and app words
+// before stop words are filtered. Host names are never normalized.
+func WithNormalization(n Normalization) Option {
+	return func(t *Tokenizer) {
+		t.normalization = n
+	}
+}
+
+// WithDecompounding splits compound words and host labels into parts known to
+// lexicon, e.g. "fussballbundesliga" into "fussball" and "bundesliga". A nil
+// lexicon uses IsGermanCompoundPart. Parts of a custom lexicon are at most 64
+// bytes long.
+func WithDecompounding(mode SplitMode, lexicon func(string) bool) Option {
+	return func(t *Tokenizer) {
+		t.maxLexiconWord = maxCustomWordPart
+		if lexicon == nil {
+			lexicon = IsGermanCompoundPart
+			t.maxLexiconWord = maxCompoundPartLength
+		}
+		t.decompounding = mode
+		t.lexicon = lexicon
+	}
+}
+
+// WithCamelCase splits path, query and fragment words at camel case boundaries
+// before they are lower cased, e.g. "HerthaBSC" into "hertha" and "bsc" and
+// "iPhoneCase" into "iphone" and "case".
+func WithCamelCase(split bool) Option {
+	return func(t *Tokenizer) {
+		t.camelCase = split
+	}
+}
+
+// WithSegmentation splits words and host labels that concatenate words
+// without separator into their most probable parts, e.g. "weatheronline" into
+// "weather" and "online". ranks return the frequency rank of a word, starting
+// at 1, or 0 for unknown words. Without ranks EnglishWordRank is used. Parts
+// known to custom ranks are at most 64 bytes long.
+func WithSegmentation(mode SplitMode, ranks ...func(string) int) Option {
+	return func(t *Tokenizer) {
+		t.maxRankedWord = maxCustomWordPart
+		if len(ranks) == 0 {
+			ranks = []func(string) int{EnglishWordRank}
+			t.maxRankedWord = maxRankedWordLength
+		}
+		t.segmentation = mode
+		t.wordRanks = ranks
+	}
+}
+
+// WithStemming replaces path, query, fragment and app words by their stem
+// after stop words are filtered, e.g. with StemGerman "fussballer" by
+// "fussball". If keepSurface is set, the word is emitted in front of its stem.
+// A nil stem disables stemming.
+func WithStemming(stem func(string) string, keepSurface bool) Option {
+	return func(t *Tokenizer) {
+		t.stem = stem
+		t.keepSurface = keepSurface
+	}
+}
+
+// WithNumberPolicy selects how words containing both letters and digits are
+// handled. By default they are skipped.
+func WithNumberPolicy(policy NumberPolicy) Option {
+	return func(t *Tokenizer) {
+		t.numberPolicy = policy
+	}
+}
+
+// WithPureNumbers emits words consisting of digits only, like years, if they
+// are at least min word size digits long.
+func WithPureNumbers(emit bool) Option {
+	return func(t *Tokenizer) {
+		t.pureNumbers = emit
+	}
+}
@@ -0,0 +1,61 @@
+package tokenizer
+
+// rules of the public suffix list, see gen/publicsuffix.go
+const (
+	// the domain is a public suffix
+	ruleNormal uint8 = 1 << iota
+	// every subdomain of the domain is a public suffix
+	ruleWildcard
+	// the domain is no public suffix despite a wildcard rule
+	ruleException
+	// the domain is the suffix of a longer rule
+	ruleParent
+)
+
+// registrableDomainStart returns the offset of the registrable domain in the
+// lower case host, i.e. its public suffix plus one more label, or -1 if host
+// is a public suffix itself. Hosts unknown to the public suffix list are
+// treated as having the last label as public suffix.
+func registrableDomainStart(host string) int {
+	for len(host) > 0 && host[len(host)-1] == '.' {
+		host = host[:len(host)-1]
+	}
+
+	// the suffixes are checked from the shortest to the longest, a longer
+	// rule overrides a shorter one. Most hosts are done after two lookups,
+	// as only suffixes of longer rules are parents.
+	suffixStart := -1
+	wildcard := false
+	for idx := len(host) - 1; idx >= 0; idx-- {
+		if host[idx] == '.' || (idx > 0 && host[idx-1] != '.') {
+			continue
+		}
+		// idx is the start of a label
+		if wildcard {
+			// every subdomain of a wildcard rule is a public suffix
+			suffixStart = idx
+		}
+		flags := publicSuffixRule(host[idx:])
+		if flags&ruleException != 0 {
+			// the public suffix starts at the next label
+			return idx
+		}
+		if flags&ruleNormal != 0 || suffixStart == -1 {
+			// without a rule the last label is the public suffix
+			suffixStart = idx
+		}
+		wildcard = flags&ruleWildcard != 0
+		if flags&(ruleWildcard|ruleParent) == 0 {
+			break
+		}
+	}
+
+	// find the label in front of the public suffix
+	registrableStart := -1
+	for idx := 0; idx < suffixStart; idx++ {
+		if host[idx] != '.' && (idx == 0 || host[idx-1] == '.') {
+			registrableStart = idx
+		}
+	}
+	return registrableStart
+}
@@ -0,0 +1,45 @@
+package tokenizer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_registrableDomainStart(t *testing.T) {
+	for host, expected := range map[string]string{
+		"example.com":            "example.com",
+		"www.example.com":        "example.com",
+		"news.bbc.co.uk":         "bbc.co.uk",
+		"bbc.co.uk.":             "bbc.co.uk.",
+		"shop.example.com.au":    "example.com.au",
+		"emetriq.github.io":      "emetriq.github.io",
+		"a.b.example.unknowntld": "example.unknowntld",
+		"..example.com":          "example.com",
+		"foo.bar.ck":             "foo.bar.ck",
+		"a.www.ck":               "www.ck",
+		"a.foo.kawasaki.jp":      "a.foo.kawasaki.jp",
+		"x.city.kawasaki.jp":     "city.kawasaki.jp",
+		"www.example.co.za":      "example.co.za",
+		"www.example.za":         "example.za",
+		"localhost":              "",
+		"co.uk":                  "",
+		"github.io":              "",
+		"com":                    "",
+	} {
+		start := registrableDomainStart(host)
+		if expected == "" {
+			assert.Equal(t, -1, start, host)
+		} else if assert.GreaterOrEqual(t, start, 0, host) {
+			assert.Equal(t, expected, host[start:], host)
+		}
+	}
+}
+
+func Test_publicSuffixRule(t *testing.T) {
+	assert.Equal(t, ruleNormal|ruleParent, publicSuffixRule("co.uk"))
+	assert.Equal(t, ruleWildcard|ruleParent, publicSuffixRule("ck"))
+	assert.Equal(t, ruleParent, publicSuffixRule("za"))
+	assert.Equal(t, ruleException, publicSuffixRule("www.ck"))
+	assert.Equal(t, uint8(0), publicSuffixRule("example.com"))
+}
@@ -0,0 +1,85 @@
+package tokenizer
+
+import "strings"
+
+// schemeKind describes how the part of a URL behind its scheme is tokenized
+type schemeKind uint8
+
+const (
+	// schemeHierarchical URLs have an authority, path, query and fragment
+	schemeHierarchical schemeKind = iota
+	// schemeOpaque URLs have no authority, the rest is tokenized like a path
+	schemeOpaque
+	// schemeMail URLs are mail addresses, only the domain of the first one
+	// is the host
+	schemeMail
+	// schemeApp URLs are deep links into the app with the bundle ID in place
+	// of the host
+	schemeApp
+	// schemeSkip URLs carry code or data that is not tokenized at all
+	schemeSkip
+)
+
+// knownSchemeKind returns how URLs with scheme are tokenized if they are not
+// tokenized like hierarchical URLs with "//" or like opaque URLs without it.
+// A switch is faster than a map for these few short schemes.
+func knownSchemeKind(scheme string) (schemeKind, bool) {
+	switch scheme {
+	case "about", "data", "javascript", "tel", "sms":
+		return schemeSkip, true
+	case "mailto":
+		return schemeMail, true
+	case "urn":
+		return schemeOpaque, true
+	case "android-app":
+		return schemeApp, true
+	}
+	return schemeHierarchical, false
+}
+
+// parseScheme returns the scheme of the lower case URL str, the index behind
+// it and how the rest of str is tokenized. Unknown schemes are hierarchical in
+// front of "//" and opaque otherwise. A leading "//" starts the authority, too.
+// A host with a port like example.com:8080
+// or with user info like user:pass@example.com is not taken for a scheme.
+func parseScheme(str string) (string, int, schemeKind) {
+	idx := 0
+	for idx < len(str) && isSchemeChar(str[idx], idx == 0) {
+		idx++
+	}
+	if idx == 0 && strings.HasPrefix(str, "//") {
+		// a network-path reference like //cdn.example.com/lib.js
+		return "", 2, schemeHierarchical
+	}
+	if idx == 0 || idx == len(str) || str[idx] != ':' {
+		return "", 0, schemeHierarchical
+	}
+
+	scheme := str[:idx]
+	kind, known := knownSchemeKind(scheme)
+	switch {
+	case kind == schemeSkip:
+		return scheme, idx + 1, kind
+	case kind == schemeApp && strings.HasPrefix(str[idx+1:], "//"):
+		return scheme, idx + 3, kind
+	case strings.HasPrefix(str[idx+1:], "//"):
+		return scheme, idx + 3, schemeHierarchical
+	case known:
+		return scheme, idx + 1, kind
+	}
+	authority := str[idx+1 : indexAnyFrom(str, idx+1, "/?#")]
+	// host:port or host: with an empty port
+	if authority == "" || isDigits(authority) || stringContainsByteChar(authority, '@') {
+		return "", 0, schemeHierarchical
+	}
+	return scheme, idx + 1, schemeOpaque
+}
+
+// isSchemeChar reports if b may be part of a scheme, which starts with a
+// letter followed by letters, digits, '+', '-' or '.'
+func isSchemeChar(b byte, first bool) bool {
+	if b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' {
+		return true
+	}
+	return !first && (b >= '0' && b <= '9' || b == '+' || b == '-' || b == '.')
+}
@@ -0,0 +1,41 @@
+package tokenizer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseScheme(t *testing.T) {
+	for str, expected := range map[string]struct {
+		scheme string
+		end    int
+		kind   schemeKind
+	}{
+		"https://example.com":                  {"https", 8, schemeHierarchical},
+		"android-app://com.example":            {"android-app", 14, schemeApp},
+		"chrome-extension://abc/options":       {"chrome-extension", 19, schemeHierarchical},
+		"git+ssh://git@example.com/repo":       {"git+ssh", 10, schemeHierarchical},
+		"mailto:info@example.com":              {"mailto", 7, schemeMail},
+		"urn:isbn:0451450523":                  {"urn", 4, schemeOpaque},
+		"tel:+4930123456":                      {"tel", 4, schemeSkip},
+		"javascript://%0aalert(1)":             {"javascript", 11, schemeSkip},
+		"data:text/html;base64,PGI+":           {"data", 5, schemeSkip},
+		"about:blank":                          {"about", 6, schemeSkip},
+		"whatsapp:send?text=hallo":             {"whatsapp", 9, schemeOpaque},
+		"spotify:track:6rqhfgbbkwnb9mlmuqdhg6": {"spotify", 8, schemeOpaque},
+		"example.com:8080/path":                {"", 0, schemeHierarchical},
+		"user:pass@example.com/path":           {"", 0, schemeHierarchical},
+		"localhost:8080":                       {"", 0, schemeHierarchical},
+		"www.example.com/a://b":                {"", 0, schemeHierarchical},
+		"1http://example.com":                  {"", 0, schemeHierarchical},
+		"https:":                               {"", 0, schemeHierarchical},
+		"//cdn.example.com/lib/jquery.min.js":  {"", 2, schemeHierarchical},
+		"":                                     {"", 0, schemeHierarchical},
+	} {
+		scheme, end, kind := parseScheme(str)
+		assert.Equal(t, expected.scheme, scheme, str)
+		assert.Equal(t, expected.end, end, str)
+		assert.Equal(t, expected.kind, kind, str)
+	}
+}
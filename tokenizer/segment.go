@@ -0,0 +1,77 @@
+package tokenizer
+
+import "math"
+
+// segmentationThreshold is the min difference of the costs of a whole word
+// and its best split, so that common words aren't split into rarer ones
+var segmentationThreshold = math.Log(1000)
+
+// wordPartCost is added to the cost of every part, so that splits into fewer
+// parts are preferred
+var wordPartCost = math.Log(10)
+
+// unknownByteCost is the cost of every byte of a word that is unknown to the
+// ranks, as if each of its letters was one of ten equally probable ones
+var unknownByteCost = math.Log(10)
+
+// wordCost returns the cost of word, the negative log of its probability
+// estimated by Zipf's law from its rank in ranks, or +Inf if it is unknown
+func wordCost(word string, ranks []func(string) int) float64 {
+	best := 0
+	for _, rank := range ranks {
+		if r := rank(word); r > 0 && (best == 0 || r < best) {
+			best = r
+		}
+	}
+	if best == 0 {
+		return math.Inf(1)
+	}
+	return math.Log(float64(best)) + wordPartCost
+}
+
+// wholeWordCost returns the cost of keeping word whole, its word cost if it is
+// known to ranks and a cost growing with its length otherwise, so that unknown
+// words are only split into much more probable parts
+func wholeWordCost(word string, ranks []func(string) int) float64 {
+	if cost := wordCost(word, ranks); !math.IsInf(cost, 1) {
+		return cost
+	}
+	return float64(len(word)) * unknownByteCost
+}
+
+// segmentWord returns the most probable split of word into at least two words
+// known to ranks, or nil if there is none or word itself is much more probable.
+// Parts are at most maxPart bytes long, which keeps long words linear.
+func segmentWord(word string, ranks []func(string) int, maxPart int) []wordPart {
+	if len(word) < 2*minWordPart {
+		return nil
+	}
+	// costs[i] is the cost of the best split of word[:i], last[i] its last
+	// part and parts[i] the number of its parts
+	costs := make([]float64, len(word)+1)
+	last := make([]wordPart, len(word)+1)
+	parts := make([]int, len(word)+1)
+	for end := 1; end <= len(word); end++ {
+		costs[end] = math.Inf(1)
+		for start := max(end-maxPart, 0); start <= end-minWordPart; start++ {
+			if math.IsInf(costs[start], 1) {
+				continue
+			}
+			if cost := costs[start] + wordCost(word[start:end], ranks); cost < costs[end] {
+				costs[end] = cost
+				last[end] = wordPart{start, end}
+				parts[end] = parts[start] + 1
+			}
+		}
+	}
+	if parts[len(word)] < 2 || costs[len(word)]+segmentationThreshold > wholeWordCost(word, ranks) {
+		return nil
+	}
+
+	result := make([]wordPart, parts[len(word)])
+	for i, n := len(result)-1, len(word); i >= 0; i-- {
+		result[i] = last[n]
+		n = last[n].start
+	}
+	return result
+}
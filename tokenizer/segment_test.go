@@ -0,0 +1,51 @@
+package tokenizer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_segmentWord(t *testing.T) {
+	ranks := []func(string) int{EnglishWordRank}
+	for word, expected := range map[string][]string{
+		"weatheronline":  {"weather", "online"},
+		"washingtonpost": {"washington", "post"},
+		"homeandgarden":  {"home", "and", "garden"},
+		// known words are kept
+		"together":   nil,
+		"understand": nil,
+		// unknown words are kept unless their parts are far more probable
+		"carpet":      nil,
+		"nowhere":     nil,
+		"passport":    nil,
+		"notebook":    nil,
+		"promotion":   nil,
+		"handball":    nil,
+		"football":    nil,
+		"notable":     nil,
+		"weekend":     nil,
+		"einkaufen":   nil,
+		"bestandteil": nil,
+		"webseite":    nil,
+		"hofmann":     nil,
+		// unknown parts
+		"bundesliga": nil,
+		"bestxbuy":   nil,
+		"":           nil,
+	} {
+		var parts []string
+		for _, p := range segmentWord(word, ranks, maxRankedWordLength) {
+			parts = append(parts, word[p.start:p.end])
+		}
+		assert.Equal(t, expected, parts, word)
+	}
+
+	// parts are never longer than the longest known word, so long runs of
+	// letters are split in linear time
+	word := strings.Repeat("weatheronline", 10000)
+	parts := segmentWord(word, ranks, maxRankedWordLength)
+	assert.Len(t, parts, 20000)
+	assert.Equal(t, "online", word[parts[len(parts)-1].start:parts[len(parts)-1].end])
+}
@@ -0,0 +1,64 @@
+package tokenizer
+
+// SplitMode selects if words that consist of several words, like the compound
+// "fussballbundesliga" or the concatenation "weatheronline", are split into
+// parts.
+type SplitMode uint8
+
+const (
+	// SplitNone keeps words as they are
+	SplitNone SplitMode = iota
+	// SplitAdd emits the parts of a word behind the word
+	SplitAdd
+	// SplitReplace emits the parts of a word instead of the word
+	SplitReplace
+)
+
+// minWordPart is the min length of a part of a word in bytes
+const minWordPart = 3
+
+// maxCustomWordPart is the max length in bytes of a part of a word known to a
+// lexicon or word ranks passed to WithDecompounding or WithSegmentation
+const maxCustomWordPart = 64
+
+// wordPart is a part of a word
+type wordPart struct {
+	start, end int
+}
+
+// emitParts passes tok to yield if mode is SplitAdd and its parts at the
+// position of tok in str
+func emitParts(str string, tok Token, parts []wordPart, mode SplitMode, yield func(Token) bool) bool {
+	if mode == SplitAdd && !yield(tok) {
+		return false
+	}
+	// transformed words have no positions of their own
+	inURL := tok.Value == str[tok.Start:tok.End]
+	for _, p := range parts {
+		part := tok
+		part.Value = tok.Value[p.start:p.end]
+		if inURL {
+			part.Start, part.End = tok.Start+p.start, tok.Start+p.end
+		}
+		if !yield(part) {
+			return false
+		}
+	}
+	return true
+}
+
+// emitSplit passes tok or its parts, if it can be split, to yield. Compounds
+// are split before concatenated words are segmented.
+func (t *Tokenizer) emitSplit(str string, tok Token, yield func(Token) bool) bool {
+	if t.decompounding != SplitNone {
+		if parts := splitCompound(tok.Value, t.lexicon, t.maxLexiconWord); parts != nil {
+			return emitParts(str, tok, parts, t.decompounding, yield)
+		}
+	}
+	if t.segmentation != SplitNone {
+		if parts := segmentWord(tok.Value, t.wordRanks, t.maxRankedWord); parts != nil {
+			return emitParts(str, tok, parts, t.segmentation, yield)
+		}
+	}
+	return yield(tok)
+}
@@ -0,0 +1,37 @@
+package tokenizer
+
+import (
+	"github.com/blevesearch/snowballstem"
+	"github.com/blevesearch/snowballstem/english"
+	"github.com/blevesearch/snowballstem/german"
+)
+
+// StemGerman returns the stem of the lower case German word found by the
+// Snowball stemmer, e.g. "fussball" for "fussballer"
+func StemGerman(word string) string {
+	env := snowballstem.NewEnv(word)
+	german.Stem(env)
+	return env.Current()
+}
+
+// StemEnglish returns the stem of the lower case English word found by the
+// Snowball stemmer, e.g. "run" for "running"
+func StemEnglish(word string) string {
+	env := snowballstem.NewEnv(word)
+	english.Stem(env)
+	return env.Current()
+}
+
+// emitStem passes tok with its stem as value to yield. If the surface form is
+// kept and differs from the stem, tok is passed as it is first.
+func (t *Tokenizer) emitStem(tok Token, yield func(Token) bool) bool {
+	stem := t.stem(tok.Value)
+	if stem == tok.Value {
+		return yield(tok)
+	}
+	if t.keepSurface && !yield(tok) {
+		return false
+	}
+	tok.Value = stem
+	return yield(tok)
+}
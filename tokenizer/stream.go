@@ -0,0 +1,112 @@
+package tokenizer
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strings"
+)
+
+// DefaultMaxLineLength is the max length of a line read by TokenizeLines if
+// no max length is set with WithMaxLength.
+const DefaultMaxLineLength = 64 * 1024
+
+// Line is the result of tokenizing a single line of a URL list.
+type Line struct {
+	// Number is the line number, starting at 1
+	Number int
+	// URL is the content of the line without line break
+	URL string
+	// Terms are the terms of URL as returned by TokenizeE
+	Terms []string
+	// Err is set if URL could not be tokenized completely
+	Err error
+}
+
+// TokenizeLines reads newline separated URLs from r and calls fn with the
+// result of every non empty line, see Tokenizer.TokenizeLines.
+func TokenizeLines(r io.Reader, fn func(Line) error, stopwordfunc ...func(string) bool) error {
+	t := defaultTokenizer(stopwordfunc...)
+	return t.TokenizeLines(r, fn)
+}
+
+// TokenizeLinesTo reads newline separated URLs from r and writes the results
+// to w, see Tokenizer.TokenizeLinesTo.
+func TokenizeLinesTo(w io.Writer, r io.Reader, stopwordfunc ...func(string) bool) error {
+	t := defaultTokenizer(stopwordfunc...)
+	return t.TokenizeLinesTo(w, r)
+}
+
+// TokenizeLines reads newline separated URLs from r, which may be gzip
+// compressed, and calls fn with the result of every non empty line. Lines
+// longer than the max length, or DefaultMaxLineLength if none is set, are cut
+// and reported as ErrTooLong, so memory use is bounded. Reading stops at the
+// first error returned by fn or r.
+func (t *Tokenizer) TokenizeLines(r io.Reader, fn func(Line) error) error {
+	maxLength := t.maxLength
+	if maxLength <= 0 {
+		maxLength = DefaultMaxLineLength
+	}
+	// room for the line break
+	bufSize := maxLength + 2
+
+	br := bufio.NewReaderSize(r, bufSize)
+	if magic, _ := br.Peek(2); len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return err
+		}
+		defer zr.Close()
+		br = bufio.NewReaderSize(zr, bufSize)
+	}
+
+	for number := 1; ; number++ {
+		line, err := br.ReadSlice('\n')
+		line = bytes.TrimRight(line, "\r\n")
+		// escapes are not cut in half
+		url, lineErr := truncate(string(line), maxLength)
+
+		// skip the rest of a line that doesn't fit into the buffer
+		for err == bufio.ErrBufferFull {
+			_, err = br.ReadSlice('\n')
+		}
+		if err != nil && err != io.EOF {
+			return err
+		}
+
+		if len(url) > 0 {
+			terms, tokErr := t.TokenizeE(url)
+			if lineErr == nil {
+				lineErr = tokErr
+			}
+			if fnErr := fn(Line{Number: number, URL: url, Terms: terms, Err: lineErr}); fnErr != nil {
+				return fnErr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+// TokenizeLinesTo reads newline separated URLs from r like TokenizeLines and
+// writes a tab separated line to w for every URL, consisting of the URL, its
+// space separated terms and the error message, if any.
+func (t *Tokenizer) TokenizeLinesTo(w io.Writer, r io.Reader) error {
+	bw := bufio.NewWriter(w)
+	err := t.TokenizeLines(r, func(line Line) error {
+		bw.WriteString(line.URL)
+		bw.WriteByte('\t')
+		bw.WriteString(strings.Join(line.Terms, " "))
+		if line.Err != nil {
+			bw.WriteByte('\t')
+			bw.WriteString(line.Err.Error())
+		}
+		return bw.WriteByte('\n')
+	})
+	if err != nil {
+		return err
+	}
+	return bw.Flush()
+}
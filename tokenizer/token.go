@@ -0,0 +1,89 @@
+package tokenizer
+
+// Kind describes the part of the URL a token was taken from.
+type Kind uint8
+
+const (
+	// KindHostLabel is a subdomain label, e.g. "sport" in sport.example.com
+	KindHostLabel Kind = iota + 1
+	// KindHost is the host name, e.g. "sport.example.com"
+	KindHost
+	// KindPath is a word of the path
+	KindPath
+	// KindQueryKey is a word of a query parameter name
+	KindQueryKey
+	// KindQueryValue is a word of a query parameter value
+	KindQueryValue
+	// KindFragment is a word of the fragment
+	KindFragment
+	// KindDomain is the registrable domain of the host, e.g. "example.co.uk"
+	// in sport.example.co.uk
+	KindDomain
+	// KindHostSuffix is a suffix of the host down to the registrable domain,
+	// e.g. "sport.example.com" and "example.com" in www.sport.example.com
+	KindHostSuffix
+	// KindBrand is the label in front of the public suffix, e.g. "example" in
+	// sport.example.co.uk
+	KindBrand
+	// KindIP is an IP address host in canonical form, e.g. "192.168.0.1" or
+	// "2001:db8::1"
+	KindIP
+	// KindAppID is the bundle ID of a mobile app, e.g. "com.spiegel.android",
+	// or its App Store ID, e.g. "id553834731"
+	KindAppID
+	// KindAppLabel is a word of the bundle ID of a mobile app, e.g. "spiegel"
+	// in com.spiegel.android
+	KindAppLabel
+	// KindID is an identifier in the path, query or fragment, like a UUID, a
+	// hex hash, a base62 or base64 blob or the value of a session param
+	KindID
+)
+
+// defaultKinds are the kinds emitted without WithKinds. Query parameters are
+// skipped, like the package level functions always did.
+const defaultKinds = 1<<KindHostLabel | 1<<KindHost | 1<<KindPath | 1<<KindFragment | 1<<KindIP | 1<<KindAppID | 1<<KindAppLabel
+
+// wordKinds are the kinds of words, as opposed to host names and IDs
+const wordKinds = 1<<KindPath | 1<<KindQueryKey | 1<<KindQueryValue | 1<<KindFragment | 1<<KindAppLabel
+
+// registrableKinds are the kinds that need the registrable domain of the host
+const registrableKinds = 1<<KindHostLabel | 1<<KindDomain | 1<<KindHostSuffix | 1<<KindBrand
+
+var kindNames = [...]string{
+	KindHostLabel:  "host-label",
+	KindHost:       "host",
+	KindPath:       "path",
+	KindQueryKey:   "query-key",
+	KindQueryValue: "query-value",
+	KindFragment:   "fragment",
+	KindDomain:     "domain",
+	KindHostSuffix: "host-suffix",
+	KindBrand:      "brand",
+	KindIP:         "ip",
+	KindAppID:      "app-id",
+	KindAppLabel:   "app-label",
+	KindID:         "id",
+}
+
+func (k Kind) String() string {
+	if int(k) < len(kindNames) && kindNames[k] != "" {
+		return kindNames[k]
+	}
+	return "unknown"
+}
+
+// Token is a single term of a URL together with its origin.
+type Token struct {
+	// Value is the normalized, lower case term
+	Value string
+	// Text is the term as written in the URL, i.e. URL[Start:End]
+	Text string
+	// Kind is the part of the URL the term was taken from
+	Kind Kind
+	// Segment is the index of the host label, path segment or query
+	// parameter the term belongs to
+	Segment int
+	// Start and End are the byte offsets of the term in the URL
+	Start int
+	End   int
+}
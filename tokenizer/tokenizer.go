@@ -3,17 +3,68 @@ package tokenizer
 import (
 	"net/url"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
+// MinWordSize is the minimum length of a path word used by the package level
+// functions. Use New with WithMinWordSize for an independent setting.
 var MinWordSize = 3
 
+// DefaultStopWordFunc is the stop word filter used by the package level
+// functions. Use New with WithStopWordFunc for an independent setting.
 var DefaultStopWordFunc = IsEnglishStopWord
 
-func isByteAllowed(b byte, isDotCountMode bool) bool {
-	if b >= 'a' && b <= 'z' {
-		return true
+// Tokenizer splits URLs into terms. Its settings are fixed by New, so a
+// Tokenizer is safe for concurrent use by multiple goroutines.
+type Tokenizer struct {
+	minWordSize    int
+	stopWordFunc   func(string) bool
+	kinds          uint32
+	maxLength      int
+	schemes        []string
+	hostForms      HostForm
+	hostPrefixes   []string
+	normalization  Normalization
+	camelCase      bool
+	decompounding  SplitMode
+	lexicon        func(string) bool
+	maxLexiconWord int
+	segmentation   SplitMode
+	wordRanks      []func(string) int
+	maxRankedWord  int
+	stem           func(string) string
+	keepSurface    bool
+	numberPolicy   NumberPolicy
+	pureNumbers    bool
+}
+
+// New returns a Tokenizer configured by opts. Without options it behaves like
+// the package level functions with their default settings.
+func New(opts ...Option) *Tokenizer {
+	t := &Tokenizer{
+		minWordSize:  3,
+		stopWordFunc: IsEnglishStopWord,
+		kinds:        defaultKinds,
 	}
-	return isDotCountMode && b != '.' && b != '/'
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// defaultTokenizer returns a snapshot of the package level settings. An
+// optional stop word func replaces DefaultStopWordFunc.
+func defaultTokenizer(stopwordfunc ...func(string) bool) Tokenizer {
+	t := Tokenizer{
+		minWordSize:  MinWordSize,
+		stopWordFunc: DefaultStopWordFunc,
+		kinds:        defaultKinds,
+	}
+	if len(stopwordfunc) > 0 {
+		t.stopWordFunc = stopwordfunc[0]
+	}
+	return t
 }
 
 // faster solution than using strings.Contains(), because we are only looking
@@ -27,133 +78,608 @@ func stringContainsByteChar(s string, r byte) bool {
 	return false
 }
 
+// indexAnyFrom returns the index of the first char of chars in s[from:] or
+// len(s) if there is none
+func indexAnyFrom(s string, from int, chars string) int {
+	if idx := strings.IndexAny(s[from:], chars); idx >= 0 {
+		return from + idx
+	}
+	return len(s)
+}
+
+// unescape decodes encodedURL if it contains escaped chars. URLs with invalid
+// escapes are returned unchanged together with the offset of the first
+// invalid escape, which is -1 otherwise.
+func unescape(encodedURL string) (string, int) {
+	if !stringContainsByteChar(encodedURL, '%') {
+		return encodedURL, -1
+	}
+	if offset := invalidEscapeOffset(encodedURL); offset >= 0 {
+		return encodedURL, offset
+	}
+	// all escapes are valid, so unescaping can't fail
+	decodedURL, _ := url.QueryUnescape(encodedURL)
+	return decodedURL, -1
+}
+
+// unescapedOffsets maps the byte offsets of the unescaped form of s back to
+// offsets in s
+func unescapedOffsets(s string) []int {
+	offsets := make([]int, 0, len(s)+1)
+	for i := 0; i < len(s); {
+		offsets = append(offsets, i)
+		if s[i] == '%' {
+			i += 3
+		} else {
+			i++
+		}
+	}
+	return append(offsets, len(s))
+}
+
+// loweredOffsets maps the byte offsets of strings.ToLower(s) back to offsets
+// in s. Invalid UTF-8 bytes are lower cased to the 3 byte replacement char.
+func loweredOffsets(s string) []int {
+	offsets := make([]int, 0, len(s)+1)
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		for n := utf8.RuneLen(unicode.ToLower(r)); n > 0; n-- {
+			offsets = append(offsets, i)
+		}
+		i += size
+	}
+	return append(offsets, len(s))
+}
+
 // Tokenize splits URL to host and path parts and tokenize path and host part
 // all terms are returned in lower case. If numbers are within a word, the complete
-// word is filtered out.
+// word is filtered out, New with WithNumberPolicy creates a Tokenizer that
+// keeps them.
 func Tokenize(encodedURL string, stopwordfunc ...func(string) bool) []string {
-	encodedURLLower := strings.ToLower(encodedURL)
-	var result []string
+	t := defaultTokenizer(stopwordfunc...)
+	return t.Tokenize(encodedURL)
+}
+
+// TokenizeFast splits URL to host and path parts and tokenize path and host part
+// all terms are returned in lower case. The URL is not unescaped and stop words
+// are only filtered if a stop word func is given.
+func TokenizeFast(encodedURL string, stopwordfunc ...func(string) bool) []string {
+	t := defaultTokenizer(stopwordfunc...)
+	if len(stopwordfunc) == 0 {
+		t.stopWordFunc = nil
+	}
+	return t.TokenizeFast(encodedURL)
+}
+
+// TokenizeE works like Tokenize, but also returns an *Error if the URL could
+// not be tokenized completely, along with the terms found anyway.
+func TokenizeE(encodedURL string, stopwordfunc ...func(string) bool) ([]string, error) {
+	t := defaultTokenizer(stopwordfunc...)
+	return t.TokenizeE(encodedURL)
+}
+
+// AppendTokens appends the terms of encodedURL, as returned by Tokenize, to
+// dst and returns the extended slice. Reusing dst avoids allocations.
+func AppendTokens(dst []string, encodedURL string, stopwordfunc ...func(string) bool) []string {
+	t := defaultTokenizer(stopwordfunc...)
+	return t.AppendTokens(dst, encodedURL)
+}
+
+// EachToken calls fn for the terms of encodedURL, as returned by Tokenize,
+// until fn returns false.
+func EachToken(encodedURL string, fn func(string) bool, stopwordfunc ...func(string) bool) {
+	t := defaultTokenizer(stopwordfunc...)
+	t.EachToken(encodedURL, fn)
+}
+
+// Tokens works like Tokenize, but returns the terms together with their kind
+// and position in the URL.
+func Tokens(encodedURL string, stopwordfunc ...func(string) bool) []Token {
+	t := defaultTokenizer(stopwordfunc...)
+	return t.Tokens(encodedURL)
+}
+
+// tokenize splits str with the package level settings, without filtering
+// stop words
+func tokenize(str string) []string {
+	t := defaultTokenizer()
+	return t.tokenize(str)
+}
+
+// filterStopWords removes stop words from terms, using DefaultStopWordFunc if
+// no stop word func is given
+func filterStopWords(terms []string, stopwordfunc ...func(string) bool) []string {
+	t := defaultTokenizer(stopwordfunc...)
+	return t.filterStopWords(terms)
+}
 
-	// check if url needs unescaping
-	if stringContainsByteChar(encodedURLLower, '%') {
-		decodedURL, err := url.QueryUnescape(encodedURLLower)
-		if err != nil {
-			escapedEncodedURL := url.QueryEscape(encodedURL)
-			decodedURL, err = url.QueryUnescape(escapedEncodedURL)
+// Tokenize splits URL to host and path parts and tokenize path and host part
+// all terms are returned in lower case. Words containing numbers are handled
+// by the number policy set with WithNumberPolicy, by default the complete word
+// is filtered out.
+func (t *Tokenizer) Tokenize(encodedURL string) []string {
+	result, _ := t.TokenizeE(encodedURL)
+	return result
+}
+
+// TokenizeE works like Tokenize, but also returns an *Error if the URL could
+// not be tokenized completely, along with the terms found anyway.
+func (t *Tokenizer) TokenizeE(encodedURL string) ([]string, error) {
+	str, cased, err := t.normalize(encodedURL)
+	return t.appendTerms(make([]string, 0, len(str)/t.minWordSize), str, cased), err
+}
+
+// AppendTokens appends the terms of encodedURL, as returned by Tokenize, to
+// dst and returns the extended slice. Reusing dst avoids allocations.
+func (t *Tokenizer) AppendTokens(dst []string, encodedURL string) []string {
+	str, cased, _ := t.normalize(encodedURL)
+	return t.appendTerms(dst, str, cased)
+}
+
+// EachToken calls fn for the terms of encodedURL, as returned by Tokenize,
+// until fn returns false.
+func (t *Tokenizer) EachToken(encodedURL string, fn func(string) bool) {
+	str, cased, _ := t.normalize(encodedURL)
+	t.each(str, cased, func(tok Token) bool {
+		return fn(tok.Value)
+	})
+}
+
+// TokenizeFast splits URL to host and path parts and tokenize path and host part
+// all terms are returned in lower case. The URL is not unescaped.
+func (t *Tokenizer) TokenizeFast(encodedURL string) []string {
+	encodedURL, _ = t.truncate(encodedURL)
+	str := strings.ToLower(encodedURL)
+	if t.checkScheme(str) != nil {
+		return []string{}
+	}
+	return t.appendTerms(make([]string, 0, len(str)/t.minWordSize), str, t.casedURL(str, encodedURL))
+}
+
+// Tokens works like Tokenize, but returns the terms together with their kind
+// and position in encodedURL.
+func (t *Tokenizer) Tokens(encodedURL string) []Token {
+	result := make([]Token, 0, len(encodedURL)/t.minWordSize)
+	t.eachToken(encodedURL, func(tok Token) bool {
+		result = append(result, tok)
+		return true
+	})
+	return result
+}
+
+// eachToken passes the tokens of encodedURL, with positions and text taken
+// from encodedURL, to yield until it returns false
+func (t *Tokenizer) eachToken(encodedURL string, yield func(Token) bool) {
+	encodedURL, _ = t.truncate(encodedURL)
+	decodedURL, _ := unescape(encodedURL)
+	str := strings.ToLower(decodedURL)
+	if t.checkScheme(str) != nil {
+		return
+	}
+
+	var offsets, lowered []int
+	if len(decodedURL) != len(encodedURL) {
+		offsets = unescapedOffsets(encodedURL)
+	}
+	// lower casing changes the length of invalid UTF-8 bytes and a few
+	// exotic runes
+	if len(str) != len(decodedURL) {
+		lowered = loweredOffsets(decodedURL)
+	}
+
+	t.each(str, t.casedURL(str, decodedURL), func(tok Token) bool {
+		if lowered != nil {
+			tok.Start, tok.End = lowered[tok.Start], lowered[tok.End]
 		}
+		if offsets != nil {
+			tok.Start, tok.End = offsets[tok.Start], offsets[tok.End]
+		}
+		tok.Text = encodedURL[tok.Start:tok.End]
+		return yield(tok)
+	})
+}
+
+// truncate cuts encodedURL to the max length, without splitting an escape.
+// It returns an *Error if encodedURL had to be cut.
+func (t *Tokenizer) truncate(encodedURL string) (string, error) {
+	return truncate(encodedURL, t.maxLength)
+}
 
-		if err != nil {
-			return []string{}
+// truncate cuts encodedURL to maxLength like Tokenizer.truncate, a maxLength
+// <= 0 means no limit
+func truncate(encodedURL string, maxLength int) (string, error) {
+	if maxLength <= 0 || len(encodedURL) <= maxLength {
+		return encodedURL, nil
+	}
+	end := maxLength
+	for i := end - 1; i >= 0 && i > maxLength-3; i-- {
+		if encodedURL[i] == '%' {
+			end = i
+			break
 		}
+	}
+	return encodedURL[:end], &Error{Err: ErrTooLong, Offset: maxLength}
+}
 
-		result = filterStopWords(tokenize(decodedURL), stopwordfunc...)
-	} else {
-		result = filterStopWords(tokenize(encodedURLLower), stopwordfunc...)
+// prepare truncates and unescapes encodedURL. It returns an *Error describing
+// the first problem found.
+func (t *Tokenizer) prepare(encodedURL string) (string, error) {
+	encodedURL, err := t.truncate(encodedURL)
+	decodedURL, offset := unescape(encodedURL)
+	if offset >= 0 && err == nil {
+		end := offset + 3
+		if end > len(encodedURL) {
+			end = len(encodedURL)
+		}
+		err = &Error{Err: ErrInvalidEscape, Offset: offset, Text: encodedURL[offset:end]}
 	}
+	return decodedURL, err
+}
 
-	return result
+// normalize truncates, unescapes and lower cases encodedURL. It also returns
+// the unescaped URL before lower casing if it is needed for camel case
+// splitting. URLs with an unsupported scheme are normalized to an empty
+// string.
+func (t *Tokenizer) normalize(encodedURL string) (string, string, error) {
+	decodedURL, err := t.prepare(encodedURL)
+	str := strings.ToLower(decodedURL)
+	if schemeErr := t.checkScheme(str); schemeErr != nil {
+		return "", "", schemeErr
+	}
+	return str, t.casedURL(str, decodedURL), err
 }
 
-// TokenizeFastV3 splits URL to host and path parts and tokenize path and host part
-// all terms are returned in lower case
-func TokenizeFast(encodedURL string, stopwordfunc ...func(string) bool) []string {
-	urlLower := strings.ToLower(encodedURL)
-	result := tokenize(urlLower)
-	if len(stopwordfunc) > 0 {
-		result = filterStopWords(result, stopwordfunc[0])
+// casedURL returns cased, the URL str before lower casing, if camel case
+// splitting is enabled and the positions in both are the same, otherwise ""
+func (t *Tokenizer) casedURL(str, cased string) string {
+	if !t.camelCase || len(str) != len(cased) {
+		return ""
+	}
+	return cased
+}
+
+// checkScheme returns an *Error if the lower case URL str has a scheme that is
+// not enabled by WithSchemes
+func (t *Tokenizer) checkScheme(str string) error {
+	if t.schemes == nil {
+		return nil
 	}
+	scheme, _, _ := parseScheme(str)
+	if scheme == "" {
+		return nil
+	}
+	for _, s := range t.schemes {
+		if s == scheme {
+			return nil
+		}
+	}
+	return &Error{Err: ErrUnsupportedScheme, Offset: 0, Text: scheme}
+}
+
+// appendTerms appends the terms of the lower case URL str without stop words
+// to dst, cased is str before lower casing or ""
+func (t *Tokenizer) appendTerms(dst []string, str, cased string) []string {
+	t.each(str, cased, func(tok Token) bool {
+		dst = append(dst, tok.Value)
+		return true
+	})
+	return dst
+}
+
+// tokenize returns all terms of the lower case URL str
+func (t *Tokenizer) tokenize(str string) []string {
+	result := make([]string, 0, len(str)/t.minWordSize)
+	t.scan(str, "", func(tok Token) bool {
+		result = append(result, tok.Value)
+		return true
+	})
 	return result
 }
 
-func tokenize(str string) []string {
+// each passes the terms of the lower case URL str that are no stop words to
+// yield, words are stemmed if enabled. cased is str before lower casing, used
+// to split camel case words, or "". It returns false if yield stopped the
+// iteration.
+func (t *Tokenizer) each(str, cased string, yield func(Token) bool) bool {
+	if t.stopWordFunc == nil && t.stem == nil {
+		return t.scan(str, cased, yield)
+	}
+	return t.scan(str, cased, func(tok Token) bool {
+		if t.stopWordFunc != nil && t.isStopWord(tok.Value) {
+			return true
+		}
+		if t.stem != nil && wordKinds&(1<<tok.Kind) != 0 {
+			return t.emitStem(tok, yield)
+		}
+		return yield(tok)
+	})
+}
+
+// scan splits the lower case URL str into host labels, path, query and
+// fragment words followed by the host name and passes them to yield. Unless
+// query params are tokenized, everything behind the first '?' is skipped. It
+// returns false if yield stopped the iteration. cased is str before lower
+// casing or "".
+func (t *Tokenizer) scan(str, cased string, yield func(Token) bool) bool {
 	// remove protocol
-	startIndex := strings.Index(str, "://")
-	if startIndex < 7 && startIndex > 0 && len(str) > startIndex+3 {
-		startIndex = startIndex + 3
-	} else {
-		startIndex = 0
+	scheme, authorityStart, schemeKind := parseScheme(str)
+	authorityEnd, pathStart, pathSegment := authorityStart, authorityStart, 0
+	switch schemeKind {
+	case schemeSkip:
+		return true
+	case schemeHierarchical, schemeApp:
+		authorityEnd = indexAnyFrom(str, authorityStart, "/?#")
+		pathStart, pathSegment = authorityEnd, -1
+	case schemeMail:
+		// only the domain of the first address, without the local part
+		authorityEnd = indexAnyFrom(str, authorityStart, ",?#")
+		if !stringContainsByteChar(str[authorityStart:authorityEnd], '@') {
+			authorityStart = authorityEnd
+		}
+		pathStart = indexAnyFrom(str, authorityEnd, "?#")
+	}
+	hostStart, hostEnd := hostName(str, authorityStart, authorityEnd)
+
+	// app IDs may be in the query, so they are taken from the whole URL
+	fullURL := str
+	appStart, appEnd := appID(str, scheme, schemeKind, hostStart, hostEnd, pathStart)
+	if schemeKind == schemeApp && appStart >= 0 {
+		hostStart = hostEnd
+	}
+
+	if t.kinds&(1<<KindQueryKey|1<<KindQueryValue|1<<KindID) == 0 {
+		// skip query params and everything behind them
+		str = str[:indexAnyFrom(str, pathStart, "?")]
+	}
+	pathEnd := indexAnyFrom(str, pathStart, "?#")
+	queryEnd := pathEnd
+	if pathEnd < len(str) && str[pathEnd] == '?' {
+		queryEnd = indexAnyFrom(str, pathEnd, "#")
+	}
+
+	// IP addresses are never split into labels
+	ip, ipStart, ipEnd, isIP := parseIPHost(str[hostStart:hostEnd], scheme != "")
+	labels := 0
+	if !isIP {
+		labels = countHostLabels(str[hostStart:hostEnd])
+	}
+	// a host of two labels has no subdomains, so the public suffix list is
+	// only looked up for it if the host name kinds or prefixes need it
+	domainLabel := -1
+	if labels > 2 && t.kinds&(1<<KindHostLabel) != 0 ||
+		labels > 1 && (t.kinds&(registrableKinds&^(1<<KindHostLabel)) != 0 || len(t.hostPrefixes) > 0) {
+		domainLabel = registrableLabel(str[hostStart:hostEnd])
+	}
+	if prefixes := t.countHostPrefixes(str[hostStart:hostEnd], domainLabel); prefixes > 0 {
+		hostStart += labelStart(str[hostStart:hostEnd], prefixes)
+		labels -= prefixes
+		domainLabel -= prefixes
 	}
 
-	strLen := len(str)
-	lastIndex := strLen - 1
-	result := make([]string, 0, strLen/MinWordSize)
+	// only the labels in front of the registrable domain are terms, a single
+	// label is kept as it is
+	if t.kinds&(1<<KindHostLabel) != 0 && labels > 0 {
+		subdomains := 1
+		if labels > 1 {
+			subdomains = max(domainLabel, 0)
+		}
+		if !t.scanHostLabels(str, hostStart, hostEnd, subdomains, yield) {
+			return false
+		}
+	}
+	if appStart >= 0 && t.kinds&(1<<KindAppLabel) != 0 && !t.scanAppLabels(fullURL, appStart, appEnd, yield) {
+		return false
+	}
+	if t.kinds&(1<<KindPath|1<<KindID) != 0 && !t.scanWords(str, cased, pathStart, pathEnd, KindPath, pathSegment, yield) {
+		return false
+	}
+	if queryEnd > pathEnd &&
+		!t.scanWords(str, cased, pathEnd+1, queryEnd, KindQueryKey, 0, yield) {
+		return false
+	}
+	if t.kinds&(1<<KindFragment|1<<KindID) != 0 && queryEnd < len(str) &&
+		!t.scanWords(str, cased, queryEnd+1, len(str), KindFragment, 0, yield) {
+		return false
+	}
+
+	if appStart >= 0 && t.kinds&(1<<KindAppID) != 0 &&
+		!yield(Token{Value: fullURL[appStart:appEnd], Kind: KindAppID, Start: appStart, End: appEnd}) {
+		return false
+	}
+	if isIP && t.kinds&(1<<KindIP) != 0 {
+		return yield(Token{Value: ip.String(), Kind: KindIP, Start: hostStart + ipStart, End: hostStart + ipEnd})
+	}
+	if labels > 1 {
+		return t.scanHost(str, hostStart, hostEnd, domainLabel, yield)
+	}
+	return true
+}
+
+// scanWords passes the words of str[from:to] to yield. Words consist of
+// letters and digits, words containing digits are handled by the number
+// policy, words shorter than the min word size are skipped. Segments that are
+// UUIDs, hashes, blobs or session params are IDs instead of words. Unless
+// cased is "", words are also split at camel case boundaries of cased. Path
+// segments are counted at '/', query parameters at '&', which also switches
+// between query keys and values.
+func (t *Tokenizer) scanWords(str, cased string, from, to int, kind Kind, segment int, yield func(Token) bool) bool {
 	start := -1
-	dotCounter := 0
-	isDotCountMode := true
 	isContainingNumber := false
-	domainNameEndIndex := -1
-	domainNameStartIndex := startIndex
-	var b byte
-	for idx := 0; idx < len(str); idx++ {
-		b = str[idx]
-		if idx < startIndex {
-			continue
+	// IDs are found per segment, words are only checked in segments with
+	// pieces that look like IDs. Most parts of URLs can't contain IDs, so they
+	// are never classified.
+	idx, checkIDs, ok := from, false, true
+	findIDs := mayContainID(str[from:to])
+	if findIDs {
+		if idx, checkIDs, ok = t.skipID(str, from, to, segment, yield); !ok {
+			return false
 		}
-
-		if isByteAllowed(b, isDotCountMode) {
+	}
+	for ; idx < to; idx++ {
+		b := str[idx]
+		// UUIDs span several words
+		if checkIDs && start == -1 && isHexLower(b) {
+			if end := uuidEnd(str, idx, to); end > 0 {
+				if !t.emitID(str, idx, end, segment, yield) {
+					return false
+				}
+				idx = end - 1
+				continue
+			}
+		}
+		if b >= 'a' && b <= 'z' {
 			if start == -1 {
 				start = idx
-			}
-			if idx == lastIndex && ((lastIndex-start+1) >= MinWordSize || isDotCountMode) {
-				if !isContainingNumber {
-					result = append(result, str[start:strLen])
+			} else if cased != "" && isCamelBoundary(cased, start, idx) {
+				if !t.emitWord(str, start, idx, isContainingNumber, checkIDs, kind, segment, yield) {
+					return false
 				}
+				start = idx
 				isContainingNumber = false
 			}
-		} else if b >= '0' && b <= '9' && !isDotCountMode {
+			continue
+		}
+		if b >= '0' && b <= '9' {
+			if start == -1 {
+				start = idx
+			}
 			isContainingNumber = true
-		} else if ((idx-start) >= MinWordSize || isDotCountMode) && start > -1 {
-			if !isContainingNumber {
-				result = append(result, str[start:idx])
+			continue
+		}
+		size := 1
+		if b >= utf8.RuneSelf {
+			var r rune
+			r, size = utf8.DecodeRuneInString(str[idx:to])
+			switch {
+			case isWordRune(r, start != -1):
+				if start == -1 {
+					start = idx
+				}
+				idx += size - 1
+				continue
+			case unicode.IsDigit(r):
+				if start == -1 {
+					start = idx
+				}
+				isContainingNumber = true
+				idx += size - 1
+				continue
 			}
-
-			isContainingNumber = false
-			start = -1
-		} else {
-			isContainingNumber = false
-			start = -1
 		}
-		if b == '/' && isDotCountMode {
-			isDotCountMode = false
-			domainNameEndIndex = idx
-			dotCounter = len(result) - 1
+
+		if !t.emitWord(str, start, idx, isContainingNumber, checkIDs, kind, segment, yield) {
+			return false
 		}
+		start = -1
+		isContainingNumber = false
 
-		if b == '?' { // skip query params
-			break
+		switch {
+		case b == '/' && kind == KindPath:
+			segment++
+		case b == '&' && (kind == KindQueryKey || kind == KindQueryValue):
+			kind = KindQueryKey
+			segment++
+		case b == '=' && kind == KindQueryKey:
+			kind = KindQueryValue
+		}
+		if findIDs && (b == '/' || b == '&' || b == ';' || b == '=') {
+			next, hasIDs, ok := t.skipID(str, idx+1, to, segment, yield)
+			if !ok {
+				return false
+			}
+			idx, checkIDs = next-1, hasIDs
+			continue
 		}
+		idx += size - 1
 	}
+	return t.emitWord(str, start, to, isContainingNumber, checkIDs, kind, segment, yield)
+}
 
-	if isDotCountMode {
-		dotCounter = len(result) - 1
-		domainNameEndIndex = len(str)
+// isCamelBoundary reports if a new word starts at idx of the word starting at
+// start in the URL cased: at a change from lower to upper case, unless a
+// single lower case letter precedes it like in iPhone, and in front of the
+// last upper case letter of an acronym followed by a word like in XMLParser
+func isCamelBoundary(cased string, start, idx int) bool {
+	prev, cur := cased[idx-1], cased[idx]
+	if cur < 'A' || cur > 'Z' {
+		return false
+	}
+	if prev >= 'a' && prev <= 'z' {
+		return idx-start > 1
 	}
+	return prev >= 'A' && prev <= 'Z' && idx+1 < len(cased) && cased[idx+1] >= 'a' && cased[idx+1] <= 'z'
+}
 
-	if dotCounter > 0 && len(result) > 1 {
-		result = append(result[:(dotCounter-1)], result[dotCounter+1:]...)
-		if domainNameEndIndex-domainNameStartIndex > 3 { // if domain name is longer than 3 chars
-			for len(str) > domainNameStartIndex && str[domainNameStartIndex] == '.' {
-				domainNameStartIndex++
-			}
-			result = append(result, str[domainNameStartIndex:domainNameEndIndex])
-		}
+// emitWord passes the word str[start:end] to yield if its kind is enabled.
+// If checkID is set, IDs are passed as such, words containing numbers are
+// handled by the number policy.
+func (t *Tokenizer) emitWord(str string, start, end int, isContainingNumber, checkID bool, kind Kind, segment int, yield func(Token) bool) bool {
+	if start == -1 {
+		return true
 	}
-	return result
+	if checkID && isID(str[start:end]) {
+		return t.emitID(str, start, end, segment, yield)
+	}
+	if t.kinds&(1<<kind) == 0 {
+		return true
+	}
+	if isContainingNumber {
+		return t.emitNumberWord(str, start, end, kind, segment, yield)
+	}
+	return t.emitTerm(str, str[start:end], start, end, kind, segment, yield)
 }
 
-func filterStopWords(terms []string, stopwordfunc ...func(string) bool) []string {
-	filter := DefaultStopWordFunc
-	if len(stopwordfunc) > 0 {
-		filter = stopwordfunc[0]
-	} else if filter == nil {
+// emitTerm passes value, the word str[start:end] or a part of it, to yield if
+// it has at least min word size runes before and after normalization
+func (t *Tokenizer) emitTerm(str, value string, start, end int, kind Kind, segment int, yield func(Token) bool) bool {
+	if t.isShortWord(value) {
+		return true
+	}
+	normalized := t.normalizeWord(value)
+	// removing diacritics may leave only a few runes or none at all
+	if len(normalized) != len(value) && t.isShortWord(normalized) {
+		return true
+	}
+	tok := Token{Value: normalized, Kind: kind, Segment: segment, Start: start, End: end}
+	return t.emitSplit(str, tok, yield)
+}
+
+// isShortWord reports if word has less than min word size runes
+func (t *Tokenizer) isShortWord(word string) bool {
+	if len(word) < t.minWordSize {
+		return true
+	}
+	// a rune has up to utf8.UTFMax bytes, so only short words are counted
+	return len(word) < t.minWordSize*utf8.UTFMax && utf8.RuneCountInString(word) < t.minWordSize
+}
+
+// isStopWord reports if term is a stop word, either as it is or without its
+// first char
+func (t *Tokenizer) isStopWord(term string) bool {
+	if term == "" {
+		return false
+	}
+	return t.stopWordFunc(term) || t.stopWordFunc(term[1:])
+}
+
+func (t *Tokenizer) filterStopWords(terms []string) []string {
+	if t.stopWordFunc == nil {
 		return terms
 	}
 
 	for i := 0; len(terms) > i; i++ {
-		if filter(terms[i]) || filter(terms[i][1:]) {
+		if t.isStopWord(terms[i]) {
 			terms = append(terms[:i], terms[i+1:]...)
 			i--
 		}
 	}
 	return terms
 }
+
+// isWordRune reports if the non ASCII rune r is part of a word: a Latin, Greek
+// or Cyrillic letter or, if it continues the word inWord, a combining mark like
+// in decomposed accented letters
+func isWordRune(r rune, inWord bool) bool {
+	return unicode.IsLetter(r) && unicode.In(r, unicode.Latin, unicode.Greek, unicode.Cyrillic) ||
+		inWord && unicode.Is(unicode.Mn, r)
+}